@@ -6,7 +6,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +19,11 @@ import (
 	"github.com/HPE/terraform-provider-hpe/subprovider"
 )
 
-var _ provider.Provider = &hpeProvider{}
+var (
+	_ provider.Provider                  = &hpeProvider{}
+	_ provider.ProviderWithActions       = &hpeProvider{}
+	_ provider.ProviderWithListResources = &hpeProvider{}
+)
 
 func New(
 	version string,
@@ -112,6 +118,8 @@ func (p *hpeProvider) Configure(
 
 	resp.ResourceData = d
 	resp.DataSourceData = d
+	resp.ActionData = d
+	resp.ListResourceData = d
 }
 
 func (p *hpeProvider) Resources(
@@ -135,3 +143,33 @@ func (p *hpeProvider) DataSources(
 
 	return datasources
 }
+
+func (p *hpeProvider) Actions(
+	ctx context.Context,
+) []func() action.Action {
+	var actions []func() action.Action
+	for _, s := range p.subproviders {
+		a, ok := s.(subprovider.SubProviderWithActions)
+		if !ok {
+			continue
+		}
+		actions = append(actions, a.GetActions(ctx)...)
+	}
+
+	return actions
+}
+
+func (p *hpeProvider) ListResources(
+	ctx context.Context,
+) []func() list.ListResource {
+	var listResources []func() list.ListResource
+	for _, s := range p.subproviders {
+		l, ok := s.(subprovider.SubProviderWithListResources)
+		if !ok {
+			continue
+		}
+		listResources = append(listResources, l.GetListResources(ctx)...)
+	}
+
+	return listResources
+}
@@ -0,0 +1,27 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build !experimental
+
+// This file is used to include the Morpheus subprovider actions in the
+// release build. It is not used in the experimental build. When building the
+// experimental version, use the `-tags experimental` flag to exclude this
+// file.
+
+// When actions are ready for production use, they should be moved to this
+// file
+
+package morpheus
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+func (s SubProvider) GetActions(
+	_ context.Context,
+) []func() action.Action {
+	actions := []func() action.Action{}
+
+	return actions
+}
@@ -0,0 +1,241 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package executeworkflow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/poll"
+)
+
+const summary = "invoke execute workflow action"
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	configure.ActionWithMorpheusConfigure
+}
+
+func (a *Action) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_execute_workflow"
+}
+
+func (a *Action) Schema(
+	ctx context.Context,
+	_ action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	resp.Schema = ExecuteWorkflowActionSchema(ctx)
+}
+
+// Poll the job execution until it reaches a terminal status, reporting each
+// status change to progress. The final job execution is returned.
+func waitForJobExecution(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+	executionID int64,
+	interval time.Duration,
+	progress func(string),
+) (sdk.ListJobExecutions200ResponseAllOfJobExecutionsInner, error) {
+	var final sdk.ListJobExecutions200ResponseAllOfJobExecutionsInner
+	execution := strconv.FormatInt(executionID, 10)
+	lastStatus := ""
+
+	err := poll.Until(ctx, interval,
+		func(ctx context.Context) (bool, error) {
+			je, hresp, err := apiClient.JobsAPI.
+				GetJobExecutions(ctx, executionID).
+				Execute()
+			if err != nil || je == nil {
+				return false, fmt.Errorf(
+					"job execution %s GET failed: %s",
+					execution, errors.ErrMsg(err, hresp),
+				)
+			}
+
+			final = je.GetJobExecution()
+			status := final.GetStatus()
+			if status != lastStatus {
+				lastStatus = status
+				progress("job execution " + execution + " status: " + status)
+			}
+
+			return isTerminal(status), nil
+		},
+	)
+
+	return final, err
+}
+
+// Describe a job execution that finished without completing, including its
+// status message and output. failed is false for a completed job execution.
+func failureDetail(
+	execution string,
+	final sdk.ListJobExecutions200ResponseAllOfJobExecutionsInner,
+) (detail string, failed bool) {
+	if final.GetStatus() == statusComplete {
+		return "", false
+	}
+
+	detail = "job execution " + execution + " finished with status " +
+		final.GetStatus()
+	if msg := final.GetStatusMessage(); msg != "" {
+		detail += ": " + msg
+	}
+	if output := final.GetResultData(); output != "" {
+		detail += "\n\n" + output
+	}
+
+	return detail, true
+}
+
+func (a *Action) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var config ExecuteWorkflowModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := a.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	job := sdk.ExecuteTasksRequestJob{}
+	job.Name = config.JobName.ValueStringPointer()
+	job.TargetType = config.TargetType.ValueStringPointer()
+	job.InstanceLabel = config.InstanceLabel.ValueStringPointer()
+	job.ServerLabel = config.ServerLabel.ValueStringPointer()
+
+	if !config.InstanceIds.IsNull() {
+		resp.Diagnostics.Append(
+			config.InstanceIds.ElementsAs(ctx, &job.Instances, false)...,
+		)
+	}
+
+	if !config.ServerIds.IsNull() {
+		resp.Diagnostics.Append(
+			config.ServerIds.ElementsAs(ctx, &job.Servers, false)...,
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.CustomOptions.IsNull() {
+		options, err := convert.ValueToAny(
+			ctx, config.CustomOptions.UnderlyingValue(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				summary,
+				"failed to convert custom_options: "+err.Error(),
+			)
+
+			return
+		}
+
+		optionsMap, ok := options.(map[string]any)
+		if !ok {
+			resp.Diagnostics.AddError(
+				summary,
+				"custom_options must be a valid object/map",
+			)
+
+			return
+		}
+		job.CustomOptions = optionsMap
+	}
+
+	workflowID := config.WorkflowId.ValueInt64()
+	workflow := strconv.FormatInt(workflowID, 10)
+
+	result, hresp, err := apiClient.AutomationAPI.
+		ExecuteWorkflows(ctx, workflowID).
+		ExecuteTasksRequest(sdk.ExecuteTasksRequest{Job: job}).
+		Execute()
+	if err != nil || result == nil || !result.GetSuccess() {
+		resp.Diagnostics.AddError(
+			summary,
+			"workflow "+workflow+" execute failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	jobExecution := result.GetJobExecution()
+	executionID, ok := jobExecution.GetIdOk()
+	if !ok {
+		resp.Diagnostics.AddError(
+			summary,
+			"workflow "+workflow+" execute returned no job execution id",
+		)
+
+		return
+	}
+	execution := strconv.FormatInt(*executionID, 10)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "workflow " + workflow + " started job execution " + execution,
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, constants.ActionTimeout)
+	defer cancel()
+
+	final, err := waitForJobExecution(waitCtx, apiClient, *executionID,
+		constants.PollInterval,
+		func(msg string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"waiting for job execution "+execution+" failed: "+err.Error(),
+		)
+
+		return
+	}
+
+	if detail, failed := failureDetail(execution, final); failed {
+		resp.Diagnostics.AddError(summary, detail)
+
+		return
+	}
+
+	if output := final.GetResultData(); output != "" {
+		resp.Diagnostics.AddWarning(
+			"workflow "+workflow+" output",
+			output,
+		)
+	}
+}
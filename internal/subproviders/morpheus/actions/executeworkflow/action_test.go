@@ -0,0 +1,116 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package executeworkflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+// executionServer answers each job execution GET with the next of
+// executions, repeating the last one once all have been served
+func executionServer(t *testing.T, executions ...string) (http.HandlerFunc, *atomic.Int64) {
+	t.Helper()
+
+	var calls atomic.Int64
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/job-executions/9", r.URL.Path)
+
+		call := int(calls.Add(1)) - 1
+		execution := executions[min(call, len(executions)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jobExecution": {"id": 9, %s}}`, execution)
+	}, &calls
+}
+
+func TestWaitForJobExecution(t *testing.T) {
+	t.Parallel()
+
+	handler, calls := executionServer(t,
+		`"status": "queued"`,
+		`"status": "running"`,
+		`"status": "running"`,
+		`"status": "complete", "resultData": "done"`,
+	)
+	client := testclient.New(t, handler)
+
+	var progress []string
+	final, err := waitForJobExecution(context.Background(), client, 9,
+		time.Millisecond, func(msg string) { progress = append(progress, msg) })
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(4), calls.Load())
+	assert.Equal(t, []string{
+		"job execution 9 status: queued",
+		"job execution 9 status: running",
+		"job execution 9 status: complete",
+	}, progress)
+	assert.Equal(t, statusComplete, final.GetStatus())
+	assert.Equal(t, "done", final.GetResultData())
+
+	_, failed := failureDetail("9", final)
+	assert.False(t, failed)
+}
+
+func TestWaitForJobExecutionFailed(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		execution string
+		detail    string
+	}{
+		"failed": {
+			execution: `"status": "failed", "statusMessage": "task error",
+				"resultData": "exit 1"`,
+			detail: "job execution 9 finished with status failed: task error\n\nexit 1",
+		},
+		"cancelled": {
+			execution: `"status": "cancelled"`,
+			detail:    "job execution 9 finished with status cancelled",
+		},
+		"error without output": {
+			execution: `"status": "error", "statusMessage": "no target"`,
+			detail:    "job execution 9 finished with status error: no target",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			handler, calls := executionServer(t, `"status": "running"`, tc.execution)
+			client := testclient.New(t, handler)
+
+			final, err := waitForJobExecution(context.Background(), client, 9,
+				time.Millisecond, func(string) {})
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), calls.Load())
+
+			detail, failed := failureDetail("9", final)
+			assert.True(t, failed)
+			assert.Equal(t, tc.detail, detail)
+		})
+	}
+}
+
+func TestWaitForJobExecutionGetFailed(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, err := waitForJobExecution(context.Background(), client, 9,
+		time.Millisecond, func(string) {})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "job execution 9 GET failed")
+}
@@ -0,0 +1,11 @@
+action "hpe_morpheus_execute_workflow" "example" {
+  config {
+    workflow_id  = 42
+    job_name     = "ExampleJob"
+    target_type  = "instance"
+    instance_ids = [hpe_morpheus_instance.example.id]
+    custom_options = {
+      environment = "dev"
+    }
+  }
+}
@@ -0,0 +1,103 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package executeworkflow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
+)
+
+func ExecuteWorkflowActionSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Execute a Morpheus workflow (task set) and wait for the " +
+			"job execution to finish.",
+		MarkdownDescription: "Execute a Morpheus workflow (task set) and wait " +
+			"for the job execution to finish.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "ID of the workflow to execute",
+				MarkdownDescription: "ID of the workflow to execute",
+			},
+			"job_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the execution job",
+				MarkdownDescription: "Name of the execution job",
+			},
+			"target_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Target context for the execution, one of " +
+					"appliance, instance, server, instance-label, server-label",
+				MarkdownDescription: "Target context for the execution, one of " +
+					"`appliance`, `instance`, `server`, `instance-label`, " +
+					"`server-label`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"appliance",
+						"instance",
+						"server",
+						"instance-label",
+						"server-label",
+					),
+				},
+			},
+			"instance_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "IDs of the instances to execute against. " +
+					"Only applicable if target_type is instance.",
+				MarkdownDescription: "IDs of the instances to execute against. " +
+					"Only applicable if `target_type` is `instance`.",
+			},
+			"server_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "IDs of the servers to execute against. " +
+					"Only applicable if target_type is server.",
+				MarkdownDescription: "IDs of the servers to execute against. " +
+					"Only applicable if `target_type` is `server`.",
+			},
+			"instance_label": schema.StringAttribute{
+				Optional: true,
+				Description: "Instance label to execute against. " +
+					"Only applicable if target_type is instance-label.",
+				MarkdownDescription: "Instance label to execute against. " +
+					"Only applicable if `target_type` is `instance-label`.",
+			},
+			"server_label": schema.StringAttribute{
+				Optional: true,
+				Description: "Server label to execute against. " +
+					"Only applicable if target_type is server-label.",
+				MarkdownDescription: "Server label to execute against. " +
+					"Only applicable if `target_type` is `server-label`.",
+			},
+			"custom_options": schema.DynamicAttribute{
+				Optional: true,
+				Description: "Map of option type values passed to the " +
+					"workflow (Dynamic)",
+				MarkdownDescription: "Map of option type values passed to the " +
+					"workflow (Dynamic)",
+				Validators: []validator.Dynamic{
+					morpheusvalidators.ValidObjectMap(),
+				},
+			},
+		},
+	}
+}
+
+type ExecuteWorkflowModel struct {
+	WorkflowId    types.Int64   `tfsdk:"workflow_id"`
+	JobName       types.String  `tfsdk:"job_name"`
+	TargetType    types.String  `tfsdk:"target_type"`
+	InstanceIds   types.Set     `tfsdk:"instance_ids"`
+	ServerIds     types.Set     `tfsdk:"server_ids"`
+	InstanceLabel types.String  `tfsdk:"instance_label"`
+	ServerLabel   types.String  `tfsdk:"server_label"`
+	CustomOptions types.Dynamic `tfsdk:"custom_options"`
+}
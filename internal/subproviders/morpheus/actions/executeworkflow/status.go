@@ -0,0 +1,18 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package executeworkflow
+
+const statusComplete = "complete"
+
+// Job execution statuses after which no further progress will be made
+var terminalStatuses = map[string]bool{
+	statusComplete: true,
+	"failed":       true,
+	"error":        true,
+	"cancelled":    true,
+	"canceled":     true,
+}
+
+func isTerminal(status string) bool {
+	return terminalStatuses[status]
+}
@@ -0,0 +1,215 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package instancepower
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/poll"
+)
+
+const summary = "invoke instance power action"
+
+const (
+	operationStart   = "start"
+	operationStop    = "stop"
+	operationRestart = "restart"
+	operationSuspend = "suspend"
+)
+
+const statusFailed = "failed"
+
+// Number of polls a restart is given to leave the running status. A restart
+// that completes between two polls is never seen leaving it, so an instance
+// still running after these polls is taken to have restarted.
+const restartPolls = 6
+
+// Instance status expected once each operation has completed
+var targetStatus = map[string]string{
+	operationStart:   "running",
+	operationStop:    "stopped",
+	operationRestart: "running",
+	operationSuspend: "suspended",
+}
+
+var (
+	_ action.Action              = &Action{}
+	_ action.ActionWithConfigure = &Action{}
+)
+
+func NewAction() action.Action {
+	return &Action{}
+}
+
+type Action struct {
+	configure.ActionWithMorpheusConfigure
+}
+
+func (a *Action) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_instance_power"
+}
+
+func (a *Action) Schema(
+	ctx context.Context,
+	_ action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	resp.Schema = InstancePowerActionSchema(ctx)
+}
+
+// Issue the power operation, returning whether the API reported success
+func powerOperation(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+	id int64,
+	operation string,
+) (bool, *http.Response, error) {
+	switch operation {
+	case operationStart:
+		r, hresp, err := apiClient.InstancesAPI.StartInstance(ctx, id).Execute()
+
+		return r.GetSuccess(), hresp, err
+	case operationStop:
+		r, hresp, err := apiClient.InstancesAPI.StopInstance(ctx, id).Execute()
+
+		return r.GetSuccess(), hresp, err
+	case operationRestart:
+		r, hresp, err := apiClient.InstancesAPI.RestartInstance(ctx, id).Execute()
+
+		return r.GetSuccess(), hresp, err
+	case operationSuspend:
+		r, hresp, err := apiClient.InstancesAPI.SuspendInstance(ctx, id).Execute()
+
+		return r.GetSuccess(), hresp, err
+	default:
+		return false, nil, fmt.Errorf("unsupported operation %q", operation)
+	}
+}
+
+// Poll the instance until it reaches the status expected once the operation
+// has completed, reporting each status change to progress. The instance is
+// still running when a restart is requested, so a restart is only complete
+// once the instance has left the running status and returned to it, or has
+// stayed running for restartPolls polls.
+func waitForStatus(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+	id int64,
+	operation string,
+	interval time.Duration,
+	progress func(string),
+) error {
+	instance := strconv.FormatInt(id, 10)
+	target := targetStatus[operation]
+	leftTarget := operation != operationRestart
+	lastStatus := ""
+	targetPolls := 0
+
+	return poll.Until(ctx, interval,
+		func(ctx context.Context) (bool, error) {
+			i, hresp, err := apiClient.InstancesAPI.GetInstance(ctx, id).Execute()
+			if err != nil || i == nil {
+				return false, fmt.Errorf(
+					"instance %s GET failed: %s",
+					instance, errors.ErrMsg(err, hresp),
+				)
+			}
+
+			inst := i.GetInstance()
+			status := inst.GetStatus()
+			if status != lastStatus {
+				lastStatus = status
+				progress("instance " + instance + " status: " + status)
+			}
+
+			if status == statusFailed {
+				return false, fmt.Errorf(
+					"instance %s entered status %s", instance, status,
+				)
+			}
+
+			if status != target {
+				leftTarget = true
+
+				return false, nil
+			}
+
+			targetPolls++
+
+			return leftTarget || targetPolls > restartPolls, nil
+		},
+	)
+}
+
+func (a *Action) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var config InstancePowerModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := a.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	id := config.InstanceId.ValueInt64()
+	instance := strconv.FormatInt(id, 10)
+	operation := config.Operation.ValueString()
+
+	success, hresp, err := powerOperation(ctx, apiClient, id, operation)
+	if err != nil || !success {
+		resp.Diagnostics.AddError(
+			summary,
+			"instance "+instance+" "+operation+" failed: "+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "instance " + instance + " " + operation + " requested",
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, constants.ActionTimeout)
+	defer cancel()
+
+	target := targetStatus[operation]
+
+	err = waitForStatus(waitCtx, apiClient, id, operation, constants.PollInterval,
+		func(msg string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"waiting for instance "+instance+" to be "+target+" failed: "+
+				err.Error(),
+		)
+
+		return
+	}
+}
@@ -0,0 +1,130 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package instancepower
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+// statusServer answers each instance GET with the next of statuses, repeating
+// the last status once all have been served
+func statusServer(t *testing.T, statuses ...string) (http.HandlerFunc, *atomic.Int64) {
+	t.Helper()
+
+	var calls atomic.Int64
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/instances/5", r.URL.Path)
+
+		call := int(calls.Add(1)) - 1
+		status := statuses[min(call, len(statuses)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"instance": {"id": 5, "status": %q}}`, status)
+	}, &calls
+}
+
+func TestWaitForStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		operation string
+		statuses  []string
+		calls     int64
+		progress  []string
+	}{
+		"start": {
+			operation: operationStart,
+			statuses:  []string{"stopped", "starting", "running"},
+			calls:     3,
+			progress:  []string{"stopped", "starting", "running"},
+		},
+		"stop already stopped": {
+			operation: operationStop,
+			statuses:  []string{"stopped"},
+			calls:     1,
+			progress:  []string{"stopped"},
+		},
+		"restart": {
+			operation: operationRestart,
+			statuses:  []string{"running", "running", "stopping", "starting", "running"},
+			calls:     5,
+			progress:  []string{"running", "stopping", "starting", "running"},
+		},
+		"suspend": {
+			operation: operationSuspend,
+			statuses:  []string{"running", "suspended"},
+			calls:     2,
+			progress:  []string{"running", "suspended"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			handler, calls := statusServer(t, tc.statuses...)
+			client := testclient.New(t, handler)
+
+			var progress []string
+			err := waitForStatus(context.Background(), client, 5, tc.operation,
+				time.Millisecond, func(msg string) { progress = append(progress, msg) })
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.calls, calls.Load())
+
+			want := make([]string, 0, len(tc.progress))
+			for _, status := range tc.progress {
+				want = append(want, "instance 5 status: "+status)
+			}
+			assert.Equal(t, want, progress)
+		})
+	}
+}
+
+func TestWaitForStatusRestartNotLeftRunning(t *testing.T) {
+	t.Parallel()
+
+	handler, calls := statusServer(t, "running")
+	client := testclient.New(t, handler)
+
+	// a restart completed between polls is only seen as running
+	err := waitForStatus(context.Background(), client, 5, operationRestart,
+		time.Millisecond, func(string) {})
+	require.NoError(t, err)
+	assert.Equal(t, int64(restartPolls+1), calls.Load())
+}
+
+func TestWaitForStatusFailed(t *testing.T) {
+	t.Parallel()
+
+	handler, calls := statusServer(t, "starting", statusFailed, "running")
+	client := testclient.New(t, handler)
+
+	err := waitForStatus(context.Background(), client, 5, operationStart,
+		time.Millisecond, func(string) {})
+	require.Error(t, err)
+	assert.Equal(t, "instance 5 entered status failed", err.Error())
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestWaitForStatusGetFailed(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	err := waitForStatus(context.Background(), client, 5, operationStart,
+		time.Millisecond, func(string) {})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "instance 5 GET failed")
+}
@@ -0,0 +1,6 @@
+action "hpe_morpheus_instance_power" "example" {
+  config {
+    instance_id = hpe_morpheus_instance.example.id
+    operation   = "restart"
+  }
+}
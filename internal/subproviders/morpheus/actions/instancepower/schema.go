@@ -0,0 +1,48 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package instancepower
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func InstancePowerActionSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Start, stop, restart or suspend a Morpheus instance and " +
+			"wait for it to reach the resulting power state.",
+		MarkdownDescription: "Start, stop, restart or suspend a Morpheus " +
+			"instance and wait for it to reach the resulting power state.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "ID of the instance",
+				MarkdownDescription: "ID of the instance",
+			},
+			"operation": schema.StringAttribute{
+				Required: true,
+				Description: "Power operation, one of start, stop, restart, " +
+					"suspend",
+				MarkdownDescription: "Power operation, one of `start`, `stop`, " +
+					"`restart`, `suspend`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						operationStart,
+						operationStop,
+						operationRestart,
+						operationSuspend,
+					),
+				},
+			},
+		},
+	}
+}
+
+type InstancePowerModel struct {
+	InstanceId types.Int64  `tfsdk:"instance_id"`
+	Operation  types.String `tfsdk:"operation"`
+}
@@ -0,0 +1,35 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+// This file is used to include experimental actions in the Morpheus
+// subprovider. It is not included in the release build. It is not intended
+// for production use and may contain unstable or incomplete features.
+
+// When building the provider, use the `-tags experimental` flag to include
+// this file.
+
+// When actions are ready for production use, they should be moved to the
+// `actions.go` file.
+
+package morpheus
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/actions/executeworkflow"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/actions/instancepower"
+)
+
+func (s SubProvider) GetActions(
+	_ context.Context,
+) []func() action.Action {
+	actions := []func() action.Action{
+		executeworkflow.NewAction,
+		instancepower.NewAction,
+	}
+
+	return actions
+}
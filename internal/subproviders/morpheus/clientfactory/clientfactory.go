@@ -121,6 +121,8 @@ func NewAPIClient(
 			Proxy: http.ProxyFromEnvironment,
 		}
 
+		transport = &floatIdRoundTripper{baseTransport: transport}
+
 		if httptrace.IsEnabled() {
 			transport = httptrace.New(transport)
 		}
@@ -0,0 +1,66 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package clientfactory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type float32IdsKey struct{}
+
+// WithFloat32Ids returns a context for a request whose path holds ids passed
+// to the sdk as float32. The sdk formats those with %v, which switches to
+// exponent notation from one million, e.g. 1e+06, so the path segments of
+// that request matching one of the ids are sent as the integer id instead.
+// Requests without the context are sent unchanged.
+func WithFloat32Ids(ctx context.Context, ids ...int64) context.Context {
+	return context.WithValue(ctx, float32IdsKey{}, ids)
+}
+
+// floatIdRoundTripper rewrites the float32 ids of requests made with
+// WithFloat32Ids back to integers
+type floatIdRoundTripper struct {
+	baseTransport http.RoundTripper
+}
+
+func (t *floatIdRoundTripper) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	ids, ok := req.Context().Value(float32IdsKey{}).([]int64)
+	if !ok {
+		return t.baseTransport.RoundTrip(req)
+	}
+
+	path := floatIdPath(req.URL.Path, ids)
+	if path == req.URL.Path {
+		return t.baseTransport.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Path = path
+	req.URL.RawPath = ""
+
+	return t.baseTransport.RoundTrip(req)
+}
+
+// floatIdPath returns the path with segments formatted by the sdk from the
+// float32 form of one of ids replaced by the integer id
+func floatIdPath(path string, ids []int64) string {
+	segments := strings.Split(path, "/")
+
+	for i, s := range segments {
+		for _, id := range ids {
+			if s == fmt.Sprintf("%v", float32(id)) {
+				segments[i] = strconv.FormatInt(id, 10)
+
+				break
+			}
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
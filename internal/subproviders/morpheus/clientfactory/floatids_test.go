@@ -0,0 +1,72 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package clientfactory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloatIdPath(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []int64{7, 999999, 1000000, 1234567, 16777216} {
+		// the sdk formats float32 path parameters with %v
+		path := fmt.Sprintf("/api/load-balancers/%v/pools/%v", float32(id), float32(id))
+		assert.Equal(t,
+			fmt.Sprintf("/api/load-balancers/%d/pools/%d", id, id),
+			floatIdPath(path, []int64{id}),
+		)
+	}
+
+	// only the segments of the given ids are rewritten
+	for _, path := range []string{
+		"/api/users/2e+06",
+		"/api/users/name-1e+06",
+		"/api/users",
+	} {
+		assert.Equal(t, path, floatIdPath(path, []int64{1000000}))
+	}
+}
+
+type recordTransport struct {
+	paths []string
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.paths = append(t.paths, req.URL.Path)
+
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestFloatIdRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	base := &recordTransport{}
+	client := &http.Client{Transport: &floatIdRoundTripper{baseTransport: base}}
+
+	// requests without float32 ids are sent unchanged
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, "http://example.com/api/users/1e+06", nil,
+	)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx := WithFloat32Ids(context.Background(), 1000000)
+	req, err = http.NewRequestWithContext(
+		ctx, http.MethodGet, "http://example.com/api/load-balancers/1e+06", nil,
+	)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"/api/users/1e+06", "/api/load-balancers/1000000"}, base.paths)
+}
@@ -9,3 +9,16 @@ const SubProviderName = "morpheus"
 // TODO: properly implement resource timeouts similar to
 // terraform-plugin-framework-timeouts
 const NetworkDeleteTimeout = 5 * time.Minute
+
+// Upper bound on how long an action waits for the work it started
+// (a workflow execution, an instance power operation) to finish
+const ActionTimeout = 30 * time.Minute
+
+// How often long running operations are polled for completion
+const PollInterval = 5 * time.Second
+
+// Number of results requested per page when listing objects
+const ListPageSize = 100
+
+// Upper bound on how long creating a cloud waits for its initial sync
+const CloudSyncTimeout = 30 * time.Minute
@@ -5,6 +5,7 @@ package convert
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +21,16 @@ func StrToType(s *string) types.String {
 	return types.StringValue(*s)
 }
 
+// StrToTypeEmptyNull converts a string the api returns as null or an empty
+// string when unset to null, so that it matches unset config
+func StrToTypeEmptyNull(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*s)
+}
+
 func StrSliceToSet(items []string) types.Set {
 	if len(items) == 0 {
 		return types.SetNull(types.StringType)
@@ -69,6 +80,23 @@ func Int64ToType(i *int64) types.Int64 {
 	return types.Int64Value(*i)
 }
 
+// SetToInt64Slice never returns a nil slice, so that a null set clears the
+// list when sent to the api
+func SetToInt64Slice(set types.Set) ([]int64, error) {
+	items := []int64{}
+
+	for _, elem := range set.Elements() {
+		switch val := elem.(type) {
+		case basetypes.Int64Value:
+			items = append(items, val.ValueInt64())
+		default:
+			return nil, fmt.Errorf("value %v is not an int64", val)
+		}
+	}
+
+	return items, nil
+}
+
 func Int64SliceToSet(items []int64) types.Set {
 	if len(items) == 0 {
 		return types.SetNull(types.Int64Type)
@@ -87,6 +115,36 @@ func Int64SliceToSet(items []int64) types.Set {
 	return set
 }
 
+// maxFloat32Id is the largest id a float32 represents exactly, 2^24. The sdk
+// formats float32 path parameters in exponent notation from one million, so
+// callers mark those requests with clientfactory.WithFloat32Ids
+const maxFloat32Id = 1 << 24
+
+// IdToFloat32 converts an id for the sdk operations that take ids as float32
+func IdToFloat32(id int64) (float32, error) {
+	if id < 0 || id > maxFloat32Id {
+		return 0, fmt.Errorf("id %d is not supported by the sdk", id)
+	}
+
+	return float32(id), nil
+}
+
+// Float32ToType converts a float32 returned by the sdk via its shortest
+// decimal representation, so that e.g. 0.1 isn't read back as
+// 0.10000000149011612 and seen as a change from the configured value
+func Float32ToType(f *float32) types.Float64 {
+	if f == nil {
+		return types.Float64Null()
+	}
+
+	v, err := strconv.ParseFloat(strconv.FormatFloat(float64(*f), 'g', -1, 32), 64)
+	if err != nil {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(v)
+}
+
 type MappingFunc[I any, O any] func(in I) O
 
 // Map objects in a slice into a Terraform Set Type according to the mapping
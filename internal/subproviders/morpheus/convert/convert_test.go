@@ -0,0 +1,77 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdToFloat32(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []int64{0, 7, 123456, 999999, 1000000, 16777215, 16777216} {
+		f, err := IdToFloat32(id)
+		assert.NoError(t, err)
+		assert.Equal(t, id, int64(f))
+	}
+
+	// 2^24 + 1 is the first integer a float32 can't represent
+	for _, id := range []int64{-1, 16777217, 1 << 31} {
+		_, err := IdToFloat32(id)
+		assert.Error(t, err)
+	}
+}
+
+func TestFloat32ToType(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []float64{0, 0.1, 0.015, 1.99, 250, 1234.56} {
+		f32 := float32(f)
+		assert.Equal(t, types.Float64Value(f), Float32ToType(&f32))
+	}
+
+	assert.True(t, Float32ToType(nil).IsNull())
+}
+
+func TestSetToInt64Slice(t *testing.T) {
+	t.Parallel()
+
+	// null and unknown sets are returned as empty slices to clear the list
+	for _, set := range []types.Set{
+		types.SetNull(types.Int64Type),
+		types.SetUnknown(types.Int64Type),
+	} {
+		items, err := SetToInt64Slice(set)
+		assert.NoError(t, err)
+		assert.NotNil(t, items)
+		assert.Empty(t, items)
+	}
+
+	set := types.SetValueMust(types.Int64Type, []attr.Value{
+		types.Int64Value(3),
+		types.Int64Value(1),
+	})
+
+	items, err := SetToInt64Slice(set)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3}, items)
+
+	_, err = SetToInt64Slice(types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("1"),
+	}))
+	assert.Error(t, err)
+}
+
+func TestStrToTypeEmptyNull(t *testing.T) {
+	t.Parallel()
+
+	empty, s := "", "a"
+
+	assert.True(t, StrToTypeEmptyNull(nil).IsNull())
+	assert.True(t, StrToTypeEmptyNull(&empty).IsNull())
+	assert.Equal(t, types.StringValue("a"), StrToTypeEmptyNull(&s))
+}
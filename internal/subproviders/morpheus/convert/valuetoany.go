@@ -10,6 +10,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// DynamicToMap converts a dynamic attribute holding an object or map into
+// the map sent to the api, a null or unknown value is returned as a nil map
+func DynamicToMap(ctx context.Context, d basetypes.DynamicValue) (map[string]any, error) {
+	if d.IsNull() || d.IsUnknown() {
+		return nil, nil
+	}
+
+	v, err := ValueToAny(ctx, d.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("value must be a valid object/map")
+	}
+
+	return m, nil
+}
+
 // ValueToAny converts a Terraform Plugin Framework Value into a Go type.
 // This function handles null values, primitive types, and complex types
 // like objects, lists, sets, tuples and maps.
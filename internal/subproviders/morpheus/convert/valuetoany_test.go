@@ -524,3 +524,25 @@ func TestValueToAnyTuple(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicToMap(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, d := range []types.Dynamic{types.DynamicNull(), types.DynamicUnknown()} {
+		m, err := DynamicToMap(ctx, d)
+		require.NoError(t, err)
+		require.Nil(t, m)
+	}
+
+	m, err := DynamicToMap(ctx, types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"enabled": types.BoolType},
+		map[string]attr.Value{"enabled": types.BoolValue(true)},
+	)))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"enabled": true}, m)
+
+	_, err = DynamicToMap(ctx, types.DynamicValue(types.StringValue("a")))
+	require.Error(t, err)
+}
@@ -4,7 +4,7 @@ package consts
 
 const (
 	ErrorNoCloudFound       = `no cloud found`
-	ErrorNoValidSearchTerms = `no valid search terms - an id or name is required`
+	ErrorNoValidSearchTerms = `no valid search terms - an id, name or name_regex is required`
 	ErrorRunningPreApply    = `Error running pre-apply plan: exit status 1`
 	ErrorMultipleClouds     = `multiple clouds were returned`
 )
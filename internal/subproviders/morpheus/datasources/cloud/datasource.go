@@ -15,6 +15,8 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/cloud/consts"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const summary = "read cloud data source"
@@ -73,30 +75,43 @@ func getCloudByName(
 	data CloudModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.ListClouds200ResponseAllOfZonesInner, error) {
-	name := data.Name.ValueString()
-
-	req := apiClient.CloudsAPI.ListClouds(ctx).Name(name)
-
-	cs, hresp, err := req.Execute()
-	if cs == nil || err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for cloud %s", name)
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
 	}
+	name, exact := m.ExactName()
+
+	clouds, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListClouds200ResponseAllOfZonesInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		req := apiClient.CloudsAPI.ListClouds(ctx).Max(pageSize).Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
 
-	var clouds []sdk.ListClouds200ResponseAllOfZonesInner
-
-	for _, c := range cs.Zones {
-		if c.GetName() == name {
-			clouds = append(clouds, c)
+		cs, hresp, err := req.Execute()
+		if cs == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for cloud with %s", m)
 		}
-	}
 
-	if len(clouds) == 1 {
-		return &clouds[0], nil
-	} else if len(clouds) > 1 {
-		return nil, errors.New(consts.ErrorMultipleClouds)
+		return cs.GetZones(), cs.Meta, nil
+	}, func(c sdk.ListClouds200ResponseAllOfZonesInner) bool {
+		return m.Match(c.GetName())
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New(consts.ErrorNoCloudFound)
+	return namematch.Select(m, clouds,
+		func(c sdk.ListClouds200ResponseAllOfZonesInner) namematch.Candidate {
+			return namematch.Candidate{Id: c.GetId(), Name: c.GetName(), DateCreated: c.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		consts.ErrorNoCloudFound,
+		consts.ErrorMultipleClouds,
+	)
 }
 
 func getCloud(
@@ -106,7 +121,7 @@ func getCloud(
 ) (*sdk.ListClouds200ResponseAllOfZonesInner, error) {
 	if !data.Id.IsNull() {
 		return getCloudByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() {
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
 		return getCloudByName(ctx, data, apiClient)
 	}
 
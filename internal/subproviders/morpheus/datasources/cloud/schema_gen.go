@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func CloudDataSourceSchema(ctx context.Context) schema.Schema {
@@ -51,9 +53,15 @@ func CloudDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.Int64{
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
 			"inventory_level": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The inventory level of the cloud",
@@ -70,6 +78,11 @@ func CloudDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Optional location for your cloud",
 				MarkdownDescription: "Optional location for your cloud",
 			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one cloud matches, use the most recently created one",
+				MarkdownDescription: "If more than one cloud matches, use the most recently created one",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -78,7 +91,20 @@ func CloudDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
+					}...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus cloud",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus cloud",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
 					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
 			"time_zone": schema.StringAttribute{
@@ -97,9 +123,12 @@ type CloudModel struct {
 	GroupIds       types.Set    `tfsdk:"group_ids"`
 	GuidanceMode   types.String `tfsdk:"guidance_mode"`
 	Id             types.Int64  `tfsdk:"id"`
+	IgnoreCase     types.Bool   `tfsdk:"ignore_case"`
 	InventoryLevel types.String `tfsdk:"inventory_level"`
 	Labels         types.Set    `tfsdk:"labels"`
 	Location       types.String `tfsdk:"location"`
+	MostRecent     types.Bool   `tfsdk:"most_recent"`
 	Name           types.String `tfsdk:"name"`
+	NameRegex      types.String `tfsdk:"name_regex"`
 	TimeZone       types.String `tfsdk:"time_zone"`
 }
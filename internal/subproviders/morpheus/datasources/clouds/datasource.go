@@ -0,0 +1,148 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package clouds
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read clouds data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_clouds"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = CloudsDataSourceSchema(ctx)
+}
+
+func listClouds(
+	ctx context.Context,
+	data CloudsModel,
+	apiClient *sdk.APIClient,
+) ([]sdk.ListClouds200ResponseAllOfZonesInner, error) {
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListClouds200ResponseAllOfZonesInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		req := apiClient.CloudsAPI.ListClouds(ctx).Max(pageSize).Offset(offset)
+		if !data.GroupId.IsNull() {
+			req = req.GroupId(data.GroupId.ValueInt64())
+		}
+
+		cs, hresp, err := req.Execute()
+		if cs == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for clouds: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return cs.GetZones(), cs.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data CloudsModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, data.Labels, data.Visibility)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	clouds, err := listClouds(ctx, data, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.Clouds = []CloudModel{}
+	for _, cloud := range clouds {
+		if !f.Match(cloud.GetName(), cloud.Labels, cloud.GetVisibility()) {
+			continue
+		}
+
+		var groupIDs []int64
+		for _, g := range cloud.Groups {
+			groupIDs = append(groupIDs, g.GetId())
+		}
+
+		data.Clouds = append(data.Clouds, CloudModel{
+			Id:         convert.Int64ToType(cloud.Id),
+			Name:       convert.StrToType(cloud.Name),
+			Code:       convert.StrToType(cloud.Code),
+			Labels:     convert.StrSliceToSet(cloud.Labels),
+			Location:   convert.StrToType(cloud.Location.Get()),
+			Visibility: convert.StrToType(cloud.Visibility),
+			Enabled:    convert.BoolToType(cloud.Enabled),
+			GroupIds:   convert.Int64SliceToSet(groupIDs),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
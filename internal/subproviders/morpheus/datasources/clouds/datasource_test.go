@@ -0,0 +1,152 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package clouds_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^prod-\"" Labels "[\"team-a\"]" GroupId 1
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const cloudsListJSON = `{
+    "zones": [
+        {
+            "id": 1,
+            "name": "prod-east",
+            "code": "prodeast",
+            "labels": ["team-a", "east"],
+            "location": "us-east",
+            "visibility": "private",
+            "enabled": true,
+            "groups": [{"id": 1, "name": "group1"}]
+        },
+        {
+            "id": 2,
+            "name": "prod-west",
+            "code": "prodwest",
+            "labels": ["team-b"],
+            "visibility": "private",
+            "enabled": true,
+            "groups": [{"id": 1, "name": "group1"}]
+        },
+        {
+            "id": 3,
+            "name": "dev-east",
+            "labels": ["team-a"],
+            "visibility": "public",
+            "enabled": false,
+            "groups": [{"id": 1, "name": "group1"}]
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 3, "total": 3}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestCloudsDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://clouds.test").
+		Get("/api/zones($)").
+		MatchParam("groupId", "1").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(cloudsListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://clouds.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^prod-"`,
+		"Labels", `["team-a"]`,
+		"GroupId", "1",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_clouds.example",
+						"clouds.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_clouds.example",
+						"clouds.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_clouds.example",
+						"clouds.0.name",
+						"prod-east",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_clouds.example",
+						"clouds.0.group_ids.#",
+						"1",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,5 @@
+data "hpe_morpheus_clouds" "example" {
+  name_regex = "^prod-"
+  labels     = ["team-a"]
+  group_id   = 1
+}
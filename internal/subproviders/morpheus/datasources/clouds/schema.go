@@ -0,0 +1,99 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package clouds
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func CloudsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Clouds matching the given filters",
+		MarkdownDescription: "Clouds matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("clouds"),
+			"labels":     filter.LabelsAttribute("clouds"),
+			"visibility": filter.VisibilityAttribute("clouds"),
+			"group_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only return clouds attached to this group",
+				MarkdownDescription: "Only return clouds attached to this group",
+			},
+			"clouds": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching clouds",
+				MarkdownDescription: "Matching clouds",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the cloud",
+							MarkdownDescription: "The ID of the cloud",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the cloud",
+							MarkdownDescription: "The name of the cloud",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Optional code for use with policies",
+							MarkdownDescription: "Optional code for use with policies",
+						},
+						"labels": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Labels of the cloud",
+							MarkdownDescription: "Labels of the cloud",
+						},
+						"location": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Optional location for the cloud",
+							MarkdownDescription: "Optional location for the cloud",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Visibility of the cloud",
+							MarkdownDescription: "Visibility of the cloud",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the cloud is enabled",
+							MarkdownDescription: "Whether the cloud is enabled",
+						},
+						"group_ids": schema.SetAttribute{
+							ElementType:         types.Int64Type,
+							Computed:            true,
+							Description:         "IDs of the groups the cloud is attached to",
+							MarkdownDescription: "IDs of the groups the cloud is attached to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type CloudsModel struct {
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Labels     types.Set    `tfsdk:"labels"`
+	Visibility types.String `tfsdk:"visibility"`
+	GroupId    types.Int64  `tfsdk:"group_id"`
+	Clouds     []CloudModel `tfsdk:"clouds"`
+}
+
+type CloudModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Code       types.String `tfsdk:"code"`
+	Labels     types.Set    `tfsdk:"labels"`
+	Location   types.String `tfsdk:"location"`
+	Visibility types.String `tfsdk:"visibility"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	GroupIds   types.Set    `tfsdk:"group_ids"`
+}
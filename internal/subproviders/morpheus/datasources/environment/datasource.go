@@ -16,11 +16,13 @@ import (
 
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const (
 	summary                   = "read environment data source"
-	ErrorNoValidSearchTerms   = `no valid search terms - an id or name is required`
+	ErrorNoValidSearchTerms   = `no valid search terms - an id, name or name_regex is required`
 	ErrorRunningPreApply      = `Error running pre-apply plan: exit status 1`
 	ErrorNoEnvironmentFound   = `no environment found`
 	ErrorMultipleEnvironments = `multiple environments were returned`
@@ -75,29 +77,50 @@ func getEnvironmentByID(
 
 func getEnvironmentByName(
 	ctx context.Context,
-	name string,
+	data EnvironmentModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.ListEnvironments200ResponseAllOfEnvironmentsInner, error) {
-	es, hresp, err := apiClient.EnvironmentsAPI.ListEnvironments(ctx).Name(name).Execute()
-	if err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for environment %s", name)
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
 	}
+	name, exact := m.ExactName()
+
+	environments, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.ListEnvironments200ResponseAllOfEnvironmentsInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		req := apiClient.EnvironmentsAPI.ListEnvironments(ctx).Max(pageSize).Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
 
-	var environments []sdk.ListEnvironments200ResponseAllOfEnvironmentsInner
-
-	for _, e := range es.Environments {
-		if e.GetName() == name {
-			environments = append(environments, e)
+		es, hresp, err := req.Execute()
+		if es == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for environment with %s", m)
 		}
-	}
 
-	if len(environments) == 1 {
-		return &environments[0], nil
-	} else if len(environments) > 1 {
-		return nil, errors.New(ErrorMultipleEnvironments)
+		return es.GetEnvironments(), es.Meta, nil
+	}, func(e sdk.ListEnvironments200ResponseAllOfEnvironmentsInner) bool {
+		return m.Match(e.GetName())
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New(ErrorNoEnvironmentFound)
+	return namematch.Select(m, environments,
+		func(e sdk.ListEnvironments200ResponseAllOfEnvironmentsInner) namematch.Candidate {
+			return namematch.Candidate{Id: e.GetId(), Name: e.GetName(), DateCreated: e.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		ErrorNoEnvironmentFound,
+		ErrorMultipleEnvironments,
+	)
 }
 
 func getEnvironment(
@@ -107,8 +130,8 @@ func getEnvironment(
 ) (*sdk.ListEnvironments200ResponseAllOfEnvironmentsInner, error) {
 	if !data.Id.IsNull() {
 		return getEnvironmentByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() {
-		return getEnvironmentByName(ctx, data.Name.ValueString(), apiClient)
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
+		return getEnvironmentByName(ctx, data, apiClient)
 	}
 
 	return nil, errors.New(ErrorNoValidSearchTerms)
@@ -154,6 +177,7 @@ func (d *DataSource) Read(
 	data.Description = convert.StrToType(environment.Description)
 	data.Id = convert.Int64ToType(environment.Id)
 	data.Name = convert.StrToType(environment.Name)
+	data.SortOrder = convert.Int64ToType(environment.SortOrder)
 	data.Visibility = convert.StrToType(environment.Visibility)
 
 	diags = resp.State.Set(ctx, &data)
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func EnvironmentDataSourceSchema(ctx context.Context) schema.Schema {
@@ -39,9 +41,20 @@ func EnvironmentDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.Int64{
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one environment matches, use the most recently created one",
+				MarkdownDescription: "If more than one environment matches, use the most recently created one",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -50,9 +63,27 @@ func EnvironmentDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus environment",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus environment",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
+					}...),
+					morpheusvalidators.RegexValidator{},
+				},
+			},
+			"sort_order": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Display order of the environment",
+				MarkdownDescription: "Display order of the environment",
+			},
 			"visibility": schema.StringAttribute{
 				Computed:            true,
 				Description:         "Whether the environment is visible in sub-tenants or not",
@@ -67,6 +98,10 @@ type EnvironmentModel struct {
 	Code        types.String `tfsdk:"code"`
 	Description types.String `tfsdk:"description"`
 	Id          types.Int64  `tfsdk:"id"`
+	IgnoreCase  types.Bool   `tfsdk:"ignore_case"`
+	MostRecent  types.Bool   `tfsdk:"most_recent"`
 	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	SortOrder   types.Int64  `tfsdk:"sort_order"`
 	Visibility  types.String `tfsdk:"visibility"`
 }
@@ -0,0 +1,146 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read environments data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_environments"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = EnvironmentsDataSourceSchema(ctx)
+}
+
+func listEnvironments(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+) ([]sdk.ListEnvironments200ResponseAllOfEnvironmentsInner, error) {
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.ListEnvironments200ResponseAllOfEnvironmentsInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		es, hresp, err := apiClient.EnvironmentsAPI.ListEnvironments(ctx).
+			Max(pageSize).
+			Offset(offset).
+			Execute()
+		if es == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"GET failed for environments: %s", errors.ErrMsg(err, hresp),
+			)
+		}
+
+		return es.GetEnvironments(), es.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data EnvironmentsModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, types.SetNull(types.StringType), data.Visibility)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	environments, err := listEnvironments(ctx, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.Environments = []EnvironmentModel{}
+	for _, e := range environments {
+		if !f.Match(e.GetName(), nil, e.GetVisibility()) {
+			continue
+		}
+
+		data.Environments = append(data.Environments, EnvironmentModel{
+			Id:          convert.Int64ToType(e.Id),
+			Name:        convert.StrToType(e.Name),
+			Code:        convert.StrToType(e.Code),
+			Description: convert.StrToType(e.Description),
+			Visibility:  convert.StrToType(e.Visibility),
+			Active:      convert.BoolToType(e.Active),
+			SortOrder:   convert.Int64ToType(e.SortOrder),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,148 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package environments_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^prod\"" Visibility "\"private\""
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const environmentsListJSON = `{
+    "environments": [
+        {
+            "id": 1,
+            "name": "production",
+            "code": "prod",
+            "description": "Production",
+            "visibility": "private",
+            "active": true,
+            "sortOrder": 0
+        },
+        {
+            "id": 2,
+            "name": "prod-shared",
+            "code": "prodshared",
+            "visibility": "public",
+            "active": true,
+            "sortOrder": 1
+        },
+        {
+            "id": 3,
+            "name": "dev",
+            "code": "dev",
+            "visibility": "private",
+            "active": true,
+            "sortOrder": 2
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 3, "total": 3}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestEnvironmentsDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://environments.test").
+		Get("/api/environments($)").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(environmentsListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://environments.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^prod"`,
+		"Visibility", `"private"`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_environments.example",
+						"environments.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_environments.example",
+						"environments.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_environments.example",
+						"environments.0.name",
+						"production",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_environments.example",
+						"environments.0.code",
+						"prod",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,4 @@
+data "hpe_morpheus_environments" "example" {
+  name_regex = "^prod"
+  visibility = "private"
+}
@@ -0,0 +1,83 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package environments
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func EnvironmentsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Environments matching the given filters",
+		MarkdownDescription: "Environments matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("environments"),
+			"visibility": filter.VisibilityAttribute("environments"),
+			"environments": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching environments",
+				MarkdownDescription: "Matching environments",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the environment",
+							MarkdownDescription: "The ID of the environment",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the environment",
+							MarkdownDescription: "The name of the environment",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the environment",
+							MarkdownDescription: "The code of the environment",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the environment",
+							MarkdownDescription: "The description of the environment",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Visibility of the environment",
+							MarkdownDescription: "Visibility of the environment",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the environment is active",
+							MarkdownDescription: "Whether the environment is active",
+						},
+						"sort_order": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Display order of the environment",
+							MarkdownDescription: "Display order of the environment",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type EnvironmentsModel struct {
+	NameRegex    types.String       `tfsdk:"name_regex"`
+	Visibility   types.String       `tfsdk:"visibility"`
+	Environments []EnvironmentModel `tfsdk:"environments"`
+}
+
+type EnvironmentModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Code        types.String `tfsdk:"code"`
+	Description types.String `tfsdk:"description"`
+	Visibility  types.String `tfsdk:"visibility"`
+	Active      types.Bool   `tfsdk:"active"`
+	SortOrder   types.Int64  `tfsdk:"sort_order"`
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/group/consts"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const summary = "read group data source"
@@ -69,29 +71,46 @@ func getGroupByID(
 
 func getGroupByName(
 	ctx context.Context,
-	name string,
+	data GroupModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.ListGroups200ResponseAllOfGroupsInner, error) {
-	gs, hresp, err := apiClient.GroupsAPI.ListGroups(ctx).Name(name).Execute()
-	if gs == nil || err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for group %s", name)
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
 	}
+	name, exact := m.ExactName()
+
+	groups, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListGroups200ResponseAllOfGroupsInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		req := apiClient.GroupsAPI.ListGroups(ctx).Max(pageSize).Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
 
-	var groups []sdk.ListGroups200ResponseAllOfGroupsInner
-
-	for _, g := range gs.Groups {
-		if g.GetName() == name {
-			groups = append(groups, g)
+		gs, hresp, err := req.Execute()
+		if gs == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for group with %s", m)
 		}
-	}
 
-	if len(groups) == 1 {
-		return &groups[0], nil
-	} else if len(groups) > 1 {
-		return nil, errors.New(consts.ErrorMultipleGroups)
+		return gs.GetGroups(), gs.Meta, nil
+	}, func(g sdk.ListGroups200ResponseAllOfGroupsInner) bool {
+		return m.Match(g.GetName())
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New(consts.ErrorNoGroupFound)
+	return namematch.Select(m, groups,
+		func(g sdk.ListGroups200ResponseAllOfGroupsInner) namematch.Candidate {
+			return namematch.Candidate{Id: g.GetId(), Name: g.GetName(), DateCreated: g.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		consts.ErrorNoGroupFound,
+		consts.ErrorMultipleGroups,
+	)
 }
 
 func getGroup(
@@ -101,8 +120,8 @@ func getGroup(
 ) (*sdk.ListGroups200ResponseAllOfGroupsInner, error) {
 	if !data.Id.IsNull() {
 		return getGroupByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() {
-		return getGroupByName(ctx, data.Name.ValueString(), apiClient)
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
+		return getGroupByName(ctx, data, apiClient)
 	}
 
 	return nil, errors.New(consts.ErrorNoValidSearchTerms)
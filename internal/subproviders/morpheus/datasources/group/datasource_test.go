@@ -4,6 +4,7 @@ package group_test
 
 //go:generate go run ../../../../../cmd/render example-id.tf.tmpl Id 99
 //go:generate go run ../../../../../cmd/render example-name.tf.tmpl Name "\"Example name\""
+//go:generate go run ../../../../../cmd/render example-name-regex.tf.tmpl NameRegex "\"^example\""
 
 import (
 	"os"
@@ -133,6 +134,51 @@ func TestAccMorpheusFindGroupByName(t *testing.T) {
 	})
 }
 
+func TestAccMorpheusFindGroupByNameRegex(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	groupResourceConfig := `
+	resource "hpe_morpheus_group" "test_group" {
+		name = "` + name + `"
+	}
+	`
+
+	// match the upper-cased name so that ignore_case is exercised too
+	dataSourceConfig, err := testhelpers.RenderExample(t,
+		"example-name-regex.tf.tmpl", "NameRegex",
+		`"^${upper(hpe_morpheus_group.test_group.name)}$"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"data.hpe_morpheus_group.test",
+			"name",
+			name,
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + groupResourceConfig + dataSourceConfig,
+				Check:  checkFn,
+			},
+		},
+	})
+}
+
 func TestAccMorpheusFindGroupNotFound(t *testing.T) {
 	defer testhelpers.RecordResult(t)
 	if testing.Short() {
@@ -0,0 +1,5 @@
+data "hpe_morpheus_group" "test" {
+  name_regex  = "^example"
+  ignore_case = true
+  most_recent = true
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func GroupDataSourceSchema(ctx context.Context) schema.Schema {
@@ -30,14 +32,25 @@ func GroupDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.Int64{
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
 			"location": schema.StringAttribute{
 				Computed:            true,
 				Description:         "Optional location argument for your group",
 				MarkdownDescription: "Optional location argument for your group",
 			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one group matches, use the most recently created one",
+				MarkdownDescription: "If more than one group matches, use the most recently created one",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -46,7 +59,20 @@ func GroupDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
+					}...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus group",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus group",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
 					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
 		},
@@ -54,8 +80,11 @@ func GroupDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type GroupModel struct {
-	Code     types.String `tfsdk:"code"`
-	Id       types.Int64  `tfsdk:"id"`
-	Location types.String `tfsdk:"location"`
-	Name     types.String `tfsdk:"name"`
+	Code       types.String `tfsdk:"code"`
+	Id         types.Int64  `tfsdk:"id"`
+	IgnoreCase types.Bool   `tfsdk:"ignore_case"`
+	Location   types.String `tfsdk:"location"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+	NameRegex  types.String `tfsdk:"name_regex"`
 }
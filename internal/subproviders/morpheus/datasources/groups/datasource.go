@@ -0,0 +1,150 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read groups data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_groups"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = GroupsDataSourceSchema(ctx)
+}
+
+func listGroups(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+) ([]sdk.ListGroups200ResponseAllOfGroupsInner, error) {
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListGroups200ResponseAllOfGroupsInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		gs, hresp, err := apiClient.GroupsAPI.ListGroups(ctx).
+			Max(pageSize).
+			Offset(offset).
+			Execute()
+		if gs == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for groups: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return gs.GetGroups(), gs.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data GroupsModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, data.Labels, types.StringNull())
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	groups, err := listGroups(ctx, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.Groups = []GroupModel{}
+	for _, group := range groups {
+		if !f.MatchName(group.GetName()) || !f.MatchLabels(group.Labels) {
+			continue
+		}
+
+		var cloudIDs []int64
+		for _, z := range group.Zones {
+			cloudIDs = append(cloudIDs, z.GetId())
+		}
+
+		if !data.CloudId.IsNull() &&
+			!slices.Contains(cloudIDs, data.CloudId.ValueInt64()) {
+			continue
+		}
+
+		data.Groups = append(data.Groups, GroupModel{
+			Id:       convert.Int64ToType(group.Id),
+			Name:     convert.StrToType(group.Name),
+			Code:     convert.StrToType(group.Code.Get()),
+			Location: convert.StrToType(group.Location.Get()),
+			Labels:   convert.StrSliceToSet(group.Labels),
+			CloudIds: convert.Int64SliceToSet(cloudIDs),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,138 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package groups_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^prod-\"" CloudId 1
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const groupsListJSON = `{
+    "groups": [
+        {
+            "id": 1,
+            "name": "prod-apps",
+            "code": "prodapps",
+            "location": "dc1",
+            "labels": ["team-a"],
+            "zones": [{"id": 1, "name": "cloud1"}]
+        },
+        {
+            "id": 2,
+            "name": "prod-db",
+            "labels": [],
+            "zones": [{"id": 2, "name": "cloud2"}]
+        },
+        {
+            "id": 3,
+            "name": "dev-apps",
+            "labels": [],
+            "zones": [{"id": 1, "name": "cloud1"}]
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 3, "total": 3}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestGroupsDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://groups.test").
+		Get("/api/groups($)").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(groupsListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://groups.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^prod-"`,
+		"CloudId", "1",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_groups.example",
+						"groups.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_groups.example",
+						"groups.0.name",
+						"prod-apps",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_groups.example",
+						"groups.0.location",
+						"dc1",
+					),
+				),
+			},
+		},
+	})
+}
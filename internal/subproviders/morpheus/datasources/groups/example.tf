@@ -0,0 +1,4 @@
+data "hpe_morpheus_groups" "example" {
+  name_regex = "^prod-"
+  cloud_id   = 1
+}
@@ -0,0 +1,85 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package groups
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func GroupsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Groups matching the given filters",
+		MarkdownDescription: "Groups matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("groups"),
+			"labels":     filter.LabelsAttribute("groups"),
+			"cloud_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only return groups that the cloud is attached to",
+				MarkdownDescription: "Only return groups that the cloud is attached to",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching groups",
+				MarkdownDescription: "Matching groups",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the group",
+							MarkdownDescription: "The ID of the group",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the group",
+							MarkdownDescription: "The name of the group",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Optional code for use with policies",
+							MarkdownDescription: "Optional code for use with policies",
+						},
+						"location": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Optional location argument for your group",
+							MarkdownDescription: "Optional location argument for your group",
+						},
+						"labels": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Labels of the group",
+							MarkdownDescription: "Labels of the group",
+						},
+						"cloud_ids": schema.SetAttribute{
+							ElementType:         types.Int64Type,
+							Computed:            true,
+							Description:         "IDs of the clouds attached to the group",
+							MarkdownDescription: "IDs of the clouds attached to the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type GroupsModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Labels    types.Set    `tfsdk:"labels"`
+	CloudId   types.Int64  `tfsdk:"cloud_id"`
+	Groups    []GroupModel `tfsdk:"groups"`
+}
+
+type GroupModel struct {
+	Id       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Code     types.String `tfsdk:"code"`
+	Location types.String `tfsdk:"location"`
+	Labels   types.Set    `tfsdk:"labels"`
+	CloudIds types.Set    `tfsdk:"cloud_ids"`
+}
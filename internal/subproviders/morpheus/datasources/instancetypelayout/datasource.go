@@ -18,12 +18,14 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const (
 	summary                          = "read instance type layout data source"
 	ErrorNoInstanceTypeLayoutFound   = `no instance type layout found`
-	ErrorNoValidSearchTerms          = `no valid search terms - an id or name is required`
+	ErrorNoValidSearchTerms          = `no valid search terms - an id, name or name_regex is required`
 	ErrorRunningPreApply             = `Error running pre-apply plan: exit status 1`
 	ErrorMultipleInstanceTypeLayouts = `multiple instance type layouts were returned`
 )
@@ -82,26 +84,45 @@ func getInstanceTypeLayoutByName(
 	data InstanceTypeLayoutModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner, error) {
-	name := data.Name.ValueString()
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
+	}
+	name, exact := m.ExactName()
 
 	// Sort by descending display order (sortOrder)
 	// https://docs.morpheusdata.com/en/latest/library/blueprints/layouts.html?highlight=high-to-low
-	req := apiClient.LibraryAPI.ListLayouts(ctx).Name(name).Sort("sortOrder").Direction("desc")
-	if !data.Version.IsNull() {
-		req = req.Max(5000) // the api doesn't support filtering by version
-	}
-
-	ls, hresp, err := req.Execute()
-	if err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for instance layout %s", name)
-	}
-
-	var layouts []sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner
+	// the api doesn't support filtering by version, so every page is
+	// fetched and the version is matched below
+	layouts, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		req := apiClient.LibraryAPI.ListLayouts(ctx).
+			Sort("sortOrder").
+			Direction("desc").
+			Max(pageSize).
+			Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
 
-	for _, l := range ls.InstanceTypeLayouts {
-		if l.GetName() == name {
-			layouts = append(layouts, l)
+		ls, hresp, err := req.Execute()
+		if ls == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for instance layout with %s", m)
 		}
+
+		return ls.GetInstanceTypeLayouts(), ls.Meta, nil
+	}, func(l sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner) bool {
+		return m.Match(l.GetName())
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if !data.Version.IsNull() {
@@ -122,7 +143,7 @@ func getInstanceTypeLayoutByName(
 		return &layouts[0], nil
 	}
 
-	return nil, errors.New(ErrorNoInstanceTypeLayoutFound)
+	return nil, fmt.Errorf("%s with %s", ErrorNoInstanceTypeLayoutFound, m)
 }
 
 func getInstanceTypeLayout(
@@ -132,7 +153,7 @@ func getInstanceTypeLayout(
 ) (*sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner, error) {
 	if !data.Id.IsNull() {
 		return getInstanceTypeLayoutByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() {
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
 		return getInstanceTypeLayoutByName(ctx, data, apiClient)
 	}
 
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func InstanceTypeLayoutDataSourceSchema(ctx context.Context) schema.Schema {
@@ -32,6 +34,11 @@ func InstanceTypeLayoutDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Morpheus ID of the instance layout",
 				MarkdownDescription: "Morpheus ID of the instance layout",
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -40,7 +47,20 @@ func InstanceTypeLayoutDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
+					}...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus instance layout",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus instance layout",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
 					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
 			"sort_order": schema.Int64Attribute{
@@ -67,7 +87,9 @@ type InstanceTypeLayoutModel struct {
 	Code        types.String `tfsdk:"code"`
 	Description types.String `tfsdk:"description"`
 	Id          types.Int64  `tfsdk:"id"`
+	IgnoreCase  types.Bool   `tfsdk:"ignore_case"`
 	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
 	SortOrder   types.Int64  `tfsdk:"sort_order"`
 	Version     types.String `tfsdk:"version"`
 }
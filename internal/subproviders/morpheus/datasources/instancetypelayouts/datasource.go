@@ -0,0 +1,164 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package instancetypelayouts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read instance type layouts data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_instance_type_layouts"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = InstanceTypeLayoutsDataSourceSchema(ctx)
+}
+
+func listLayouts(
+	ctx context.Context,
+	data InstanceTypeLayoutsModel,
+	apiClient *sdk.APIClient,
+) ([]sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner, error) {
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.GetInstanceType200ResponseInstanceTypeInstanceTypeLayoutsInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		req := apiClient.LibraryAPI.ListLayouts(ctx).Max(pageSize).Offset(offset)
+		if !data.ProvisionType.IsNull() {
+			req = req.ProvisionType(data.ProvisionType.ValueString())
+		}
+
+		ls, hresp, err := req.Execute()
+		if ls == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"GET failed for instance type layouts: %s", errors.ErrMsg(err, hresp),
+			)
+		}
+
+		return ls.GetInstanceTypeLayouts(), ls.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data InstanceTypeLayoutsModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, data.Labels, types.StringNull())
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	layouts, err := listLayouts(ctx, data, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.InstanceTypeLayouts = []InstanceTypeLayoutModel{}
+	for _, layout := range layouts {
+		if !f.MatchName(layout.GetName()) || !f.MatchLabels(layout.Labels) {
+			continue
+		}
+
+		if !data.Version.IsNull() &&
+			data.Version.ValueString() != layout.GetInstanceVersion() {
+			continue
+		}
+
+		instanceTypeID := types.Int64Null()
+		if it, ok := layout.GetInstanceTypeOk(); ok {
+			instanceTypeID = convert.Int64ToType(it.Id)
+		}
+
+		provisionType := types.StringNull()
+		if pt, ok := layout.GetProvisionTypeOk(); ok {
+			provisionType = convert.StrToType(pt.Code)
+		}
+
+		data.InstanceTypeLayouts = append(data.InstanceTypeLayouts, InstanceTypeLayoutModel{
+			Id:             convert.Int64ToType(layout.Id),
+			Name:           convert.StrToType(layout.Name),
+			Code:           convert.StrToType(layout.Code),
+			Version:        convert.StrToType(layout.InstanceVersion),
+			Labels:         convert.StrSliceToSet(layout.Labels),
+			InstanceTypeId: instanceTypeID,
+			ProvisionType:  provisionType,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,142 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package instancetypelayouts_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^ubuntu\"" Version "\"22.04\"" ProvisionType "\"vmware\""
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const layoutsListJSON = `{
+    "instanceTypeLayouts": [
+        {
+            "id": 1,
+            "name": "ubuntu-vmware",
+            "code": "ubuntu-vmware-2204",
+            "instanceVersion": "22.04",
+            "labels": ["linux"],
+            "instanceType": {"id": 10, "code": "ubuntu", "name": "Ubuntu"},
+            "provisionType": {"id": 1, "code": "vmware"}
+        },
+        {
+            "id": 2,
+            "name": "ubuntu-vmware",
+            "code": "ubuntu-vmware-2004",
+            "instanceVersion": "20.04",
+            "instanceType": {"id": 10, "code": "ubuntu", "name": "Ubuntu"},
+            "provisionType": {"id": 1, "code": "vmware"}
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 2, "total": 2}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestInstanceTypeLayoutsDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://layouts.test").
+		Get("/api/library/layouts($)").
+		MatchParam("provisionType", "vmware").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(layoutsListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://layouts.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^ubuntu"`,
+		"Version", `"22.04"`,
+		"ProvisionType", `"vmware"`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_instance_type_layouts.example",
+						"instance_type_layouts.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_instance_type_layouts.example",
+						"instance_type_layouts.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_instance_type_layouts.example",
+						"instance_type_layouts.0.instance_type_id",
+						"10",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_instance_type_layouts.example",
+						"instance_type_layouts.0.provision_type",
+						"vmware",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,5 @@
+data "hpe_morpheus_instance_type_layouts" "example" {
+  name_regex     = "^ubuntu"
+  version        = "22.04"
+  provision_type = "vmware"
+}
@@ -0,0 +1,96 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package instancetypelayouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func InstanceTypeLayoutsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Instance type layouts matching the given filters",
+		MarkdownDescription: "Instance type layouts matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("instance type layouts"),
+			"labels":     filter.LabelsAttribute("instance type layouts"),
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return instance type layouts with this version",
+				MarkdownDescription: "Only return instance type layouts with this version",
+			},
+			"provision_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return instance type layouts for this provision type code",
+				MarkdownDescription: "Only return instance type layouts for this provision type code",
+			},
+			"instance_type_layouts": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching instance type layouts",
+				MarkdownDescription: "Matching instance type layouts",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the instance type layout",
+							MarkdownDescription: "The ID of the instance type layout",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the instance type layout",
+							MarkdownDescription: "The name of the instance type layout",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the instance type layout",
+							MarkdownDescription: "The code of the instance type layout",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The version of the instance type layout",
+							MarkdownDescription: "The version of the instance type layout",
+						},
+						"labels": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Labels of the instance type layout",
+							MarkdownDescription: "Labels of the instance type layout",
+						},
+						"instance_type_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the instance type of the layout",
+							MarkdownDescription: "The ID of the instance type of the layout",
+						},
+						"provision_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the provision type of the layout",
+							MarkdownDescription: "The code of the provision type of the layout",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type InstanceTypeLayoutsModel struct {
+	NameRegex           types.String              `tfsdk:"name_regex"`
+	Labels              types.Set                 `tfsdk:"labels"`
+	Version             types.String              `tfsdk:"version"`
+	ProvisionType       types.String              `tfsdk:"provision_type"`
+	InstanceTypeLayouts []InstanceTypeLayoutModel `tfsdk:"instance_type_layouts"`
+}
+
+type InstanceTypeLayoutModel struct {
+	Id             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Code           types.String `tfsdk:"code"`
+	Version        types.String `tfsdk:"version"`
+	Labels         types.Set    `tfsdk:"labels"`
+	InstanceTypeId types.Int64  `tfsdk:"instance_type_id"`
+	ProvisionType  types.String `tfsdk:"provision_type"`
+}
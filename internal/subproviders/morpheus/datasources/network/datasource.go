@@ -6,7 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +16,8 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 var _ datasource.DataSource = &DataSource{}
@@ -53,11 +55,11 @@ func getNetwork(
 	if !config.Id.IsNull() {
 		return getNetworkByID(ctx, config.Id.ValueInt64(), client)
 	}
-	if !config.Name.IsNull() {
-		return getNetworkByName(ctx, config.Name.ValueString(), client)
+	if !config.Name.IsNull() || !config.NameRegex.IsNull() {
+		return getNetworkByName(ctx, config, client)
 	}
 
-	return nil, fmt.Errorf("either id or name must be specified")
+	return nil, fmt.Errorf("either id, name or name_regex must be specified")
 }
 
 func getNetworkByID(
@@ -92,44 +94,58 @@ func getNetworkByID(
 
 func getNetworkByName(
 	ctx context.Context,
-	name string,
+	config NetworkModel,
 	client *sdk.APIClient,
 ) (*NetworkModel, error) {
-	networks, hresp, err := client.NetworksAPI.ListNetworks(ctx).Name(name).Execute()
-	if err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("network %s list failed: %s", name, errors.ErrMsg(err, hresp))
-	}
-
-	var matchingNetworks []sdk.ListNetworks200ResponseAllOfNetworksInner
-	for _, network := range networks.GetNetworks() {
-		if networkName, ok := network.GetNameOk(); ok && *networkName == name {
-			matchingNetworks = append(matchingNetworks, network)
-		}
+	m, err := namematch.New(config.Name, config.NameRegex, config.IgnoreCase)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(matchingNetworks) == 0 {
-		return nil, fmt.Errorf("network %s not found", name)
+	query := url.Values{}
+	if name, exact := m.ExactName(); exact {
+		query.Set("name", name)
 	}
 
-	if len(matchingNetworks) > 1 {
-		var networkIDs []string
-		for _, n := range matchingNetworks {
-			if id, ok := n.GetIdOk(); ok {
-				networkIDs = append(networkIDs, fmt.Sprintf("%d", *id))
-			}
+	matchingNetworks, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListNetworks200ResponseAllOfNetworksInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		// the SDK request builder for ListNetworks has no max/offset
+		networks, hresp, err := paginate.GetPage[sdk.ListNetworks200Response](
+			ctx, client, "NetworksAPIService.ListNetworks", "/api/networks",
+			query, pageSize, offset,
+		)
+		if networks == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"network list with %s failed: %s", m, errors.ErrMsg(err, hresp),
+			)
 		}
 
-		return nil, fmt.Errorf(
-			"multiple networks found with name %s. Network IDs: %s. "+
-				"Please specify an ID instead",
-			name,
-			strings.Join(networkIDs, ", "),
-		)
+		return networks.GetNetworks(), networks.Meta, nil
+	}, func(network sdk.ListNetworks200ResponseAllOfNetworksInner) bool {
+		return m.Match(network.GetName())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := namematch.Select(m, matchingNetworks,
+		func(n sdk.ListNetworks200ResponseAllOfNetworksInner) namematch.Candidate {
+			return namematch.Candidate{Id: n.GetId(), Name: n.GetName()}
+		},
+		config.MostRecent.ValueBool(),
+		"network not found",
+		"multiple networks found",
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	id, ok := matchingNetworks[0].GetIdOk()
+	id, ok := network.GetIdOk()
 	if !ok {
-		return nil, fmt.Errorf("network %s has missing ID", name)
+		return nil, fmt.Errorf("network %s has missing ID", network.GetName())
 	}
 
 	return getNetworkByID(ctx, *id, client)
@@ -167,5 +183,10 @@ func (d *DataSource) Read(
 		return
 	}
 
+	// the lookup options only exist in config
+	state.IgnoreCase = config.IgnoreCase
+	state.MostRecent = config.MostRecent
+	state.NameRegex = config.NameRegex
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
@@ -42,13 +44,24 @@ func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.Int64{
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
 			"labels": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one network matches, use the most recently created one",
+				MarkdownDescription: "If more than one network matches, use the most recently created one",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -57,7 +70,20 @@ func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
+					}...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus network",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus network",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
 					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
 			"visibility": schema.StringAttribute{
@@ -73,7 +99,10 @@ type NetworkModel struct {
 	Description types.String `tfsdk:"description"`
 	DisplayName types.String `tfsdk:"display_name"`
 	Id          types.Int64  `tfsdk:"id"`
+	IgnoreCase  types.Bool   `tfsdk:"ignore_case"`
 	Labels      types.Set    `tfsdk:"labels"`
+	MostRecent  types.Bool   `tfsdk:"most_recent"`
 	Name        types.String `tfsdk:"name"`
+	NameRegex   types.String `tfsdk:"name_regex"`
 	Visibility  types.String `tfsdk:"visibility"`
 }
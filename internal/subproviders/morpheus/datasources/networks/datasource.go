@@ -0,0 +1,166 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read networks data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_networks"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = NetworksDataSourceSchema(ctx)
+}
+
+func listNetworks(
+	ctx context.Context,
+	data NetworksModel,
+	apiClient *sdk.APIClient,
+) ([]sdk.ListNetworks200ResponseAllOfNetworksInner, error) {
+	query := url.Values{}
+	if !data.CloudId.IsNull() {
+		query.Set("zoneId", strconv.FormatInt(data.CloudId.ValueInt64(), 10))
+	}
+
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListNetworks200ResponseAllOfNetworksInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		// the SDK request builder for ListNetworks has no max/offset
+		ns, hresp, err := paginate.GetPage[sdk.ListNetworks200Response](
+			ctx, apiClient, "NetworksAPIService.ListNetworks", "/api/networks",
+			query, pageSize, offset,
+		)
+		if ns == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for networks: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return ns.GetNetworks(), ns.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data NetworksModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, data.Labels, data.Visibility)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	networks, err := listNetworks(ctx, data, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.Networks = []NetworkModel{}
+	for _, n := range networks {
+		if !f.Match(n.GetName(), n.Labels, n.GetVisibility()) {
+			continue
+		}
+
+		groupID := types.Int64Null()
+		if g, ok := n.GetGroupOk(); ok {
+			groupID = convert.Int64ToType(g.Id)
+		}
+
+		if !data.GroupId.IsNull() && !data.GroupId.Equal(groupID) {
+			continue
+		}
+
+		cloudID := types.Int64Null()
+		if z, ok := n.GetZoneOk(); ok {
+			cloudID = convert.Int64ToType(z.Id)
+		}
+
+		data.Networks = append(data.Networks, NetworkModel{
+			Id:          convert.Int64ToType(n.Id),
+			Name:        convert.StrToType(n.Name),
+			DisplayName: convert.StrToType(n.DisplayName.Get()),
+			Description: convert.StrToType(n.Description.Get()),
+			Cidr:        convert.StrToType(n.Cidr.Get()),
+			Labels:      convert.StrSliceToSet(n.Labels),
+			Visibility:  convert.StrToType(n.Visibility),
+			Active:      convert.BoolToType(n.Active),
+			CloudId:     cloudID,
+			GroupId:     groupID,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
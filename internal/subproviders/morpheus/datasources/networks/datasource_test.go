@@ -0,0 +1,143 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package networks_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl CloudId 1 NameRegex "\"^app-\""
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const networksListJSON = `{
+    "networks": [
+        {
+            "id": 1,
+            "name": "app-net",
+            "displayName": "App network",
+            "cidr": "10.0.0.0/24",
+            "labels": ["team-a"],
+            "visibility": "private",
+            "active": true,
+            "zone": {"id": 1, "name": "cloud1"},
+            "group": {"id": 2, "name": "group2"}
+        },
+        {
+            "id": 2,
+            "name": "db-net",
+            "cidr": "10.0.1.0/24",
+            "visibility": "private",
+            "active": true,
+            "zone": {"id": 1, "name": "cloud1"}
+        }
+    ],
+    "meta": {"offset": 0, "max": 25, "size": 2, "total": 2}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestNetworksDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://networks.test").
+		Get("/api/networks($)").
+		MatchParam("zoneId", "1").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(networksListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://networks.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"CloudId", `1`,
+		"NameRegex", `"^app-"`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_networks.example",
+						"networks.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_networks.example",
+						"networks.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_networks.example",
+						"networks.0.cidr",
+						"10.0.0.0/24",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_networks.example",
+						"networks.0.group_id",
+						"2",
+					),
+				),
+			},
+		},
+	})
+}
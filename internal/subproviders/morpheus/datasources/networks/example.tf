@@ -0,0 +1,4 @@
+data "hpe_morpheus_networks" "example" {
+  cloud_id   = 1
+  name_regex = "^app-"
+}
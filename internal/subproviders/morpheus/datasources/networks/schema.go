@@ -0,0 +1,116 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func NetworksDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Networks matching the given filters",
+		MarkdownDescription: "Networks matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("networks"),
+			"labels":     filter.LabelsAttribute("networks"),
+			"visibility": filter.VisibilityAttribute("networks"),
+			"cloud_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only return networks in this cloud (zone)",
+				MarkdownDescription: "Only return networks in this cloud (zone)",
+			},
+			"group_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only return networks assigned to this group",
+				MarkdownDescription: "Only return networks assigned to this group",
+			},
+			"networks": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching networks",
+				MarkdownDescription: "Matching networks",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the network",
+							MarkdownDescription: "The ID of the network",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the network",
+							MarkdownDescription: "The name of the network",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The display name of the network",
+							MarkdownDescription: "The display name of the network",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the network",
+							MarkdownDescription: "The description of the network",
+						},
+						"cidr": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The CIDR of the network",
+							MarkdownDescription: "The CIDR of the network",
+						},
+						"labels": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "Labels of the network",
+							MarkdownDescription: "Labels of the network",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Visibility of the network",
+							MarkdownDescription: "Visibility of the network",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the network is active",
+							MarkdownDescription: "Whether the network is active",
+						},
+						"cloud_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the cloud (zone) of the network",
+							MarkdownDescription: "The ID of the cloud (zone) of the network",
+						},
+						"group_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the group the network is assigned to",
+							MarkdownDescription: "The ID of the group the network is assigned to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type NetworksModel struct {
+	NameRegex  types.String   `tfsdk:"name_regex"`
+	Labels     types.Set      `tfsdk:"labels"`
+	Visibility types.String   `tfsdk:"visibility"`
+	CloudId    types.Int64    `tfsdk:"cloud_id"`
+	GroupId    types.Int64    `tfsdk:"group_id"`
+	Networks   []NetworkModel `tfsdk:"networks"`
+}
+
+type NetworkModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Cidr        types.String `tfsdk:"cidr"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Visibility  types.String `tfsdk:"visibility"`
+	Active      types.Bool   `tfsdk:"active"`
+	CloudId     types.Int64  `tfsdk:"cloud_id"`
+	GroupId     types.Int64  `tfsdk:"group_id"`
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/role/consts"
 	providererrors "github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const summary = "read role data source"
@@ -300,28 +302,48 @@ func getRoleByName(
 	data RoleModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.GetRole200Response, error) {
-	name := data.Name.ValueString()
-
-	rs, hresp, err := apiClient.RolesAPI.ListRoles(ctx).Authority(name).Execute()
-	if rs == nil || err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for role %s", name)
-	}
-
-	var roles []sdk.ListRoles200ResponseAllOfRolesInner
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
+	}
+	name, exact := m.ExactName()
+
+	roles, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListRoles200ResponseAllOfRolesInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		req := apiClient.RolesAPI.ListRoles(ctx).Max(pageSize).Offset(offset)
+		if exact {
+			req = req.Authority(name)
+		}
 
-	for _, c := range rs.Roles {
-		if c.GetName() == name {
-			roles = append(roles, c)
+		rs, hresp, err := req.Execute()
+		if rs == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for role with %s", m)
 		}
+
+		return rs.GetRoles(), rs.Meta, nil
+	}, func(r sdk.ListRoles200ResponseAllOfRolesInner) bool {
+		return m.Match(r.GetName())
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if len(roles) == 1 {
-		return getRoleByID(ctx, roles[0].GetId(), apiClient)
-	} else if len(roles) > 1 {
-		return nil, errors.New(consts.ErrorMultipleRoles)
+	role, err := namematch.Select(m, roles,
+		func(r sdk.ListRoles200ResponseAllOfRolesInner) namematch.Candidate {
+			return namematch.Candidate{Id: r.GetId(), Name: r.GetName(), DateCreated: r.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		consts.ErrorNoRoleFound,
+		consts.ErrorMultipleRoles,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New(consts.ErrorNoRoleFound)
+	return getRoleByID(ctx, role.GetId(), apiClient)
 }
 
 func getRole(
@@ -331,7 +353,7 @@ func getRole(
 ) (*sdk.GetRole200Response, error) {
 	if !data.Id.IsNull() {
 		return getRoleByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() {
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
 		return getRoleByName(ctx, data, apiClient)
 	}
 
@@ -393,5 +415,10 @@ func (d *DataSource) Read(
 		apiState.Permissions.DefaultGroupAccess = types.StringNull()
 	}
 
+	// the lookup options only exist in config
+	apiState.IgnoreCase = data.IgnoreCase
+	apiState.MostRecent = data.MostRecent
+	apiState.NameRegex = data.NameRegex
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &apiState)...)
 }
@@ -17,6 +17,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func RoleDataSourceSchema(ctx context.Context) schema.Schema {
@@ -33,14 +35,25 @@ func RoleDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.Int64{
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
 			"landing_url": schema.StringAttribute{
 				Computed:            true,
 				Description:         "An optional override for the default landing page after login for a user.",
 				MarkdownDescription: "An optional override for the default landing page after login for a user.",
 			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one role matches, use the most recently created one",
+				MarkdownDescription: "If more than one role matches, use the most recently created one",
+			},
 			"multitenant": schema.BoolAttribute{
 				Computed:            true,
 				Description:         "Multitenant roles are copied to all tenant accounts and kept in sync until a sub-tenant user modifies their copy of the role. *Only available to master tenant*",
@@ -59,7 +72,20 @@ func RoleDataSourceSchema(ctx context.Context) schema.Schema {
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("id"),
+						path.MatchRoot("name_regex"),
+					}...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A regular expression matching the name of the Morpheus role",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus role",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
 					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
 			"permissions": schema.SingleNestedAttribute{
@@ -609,10 +635,13 @@ func RoleDataSourceSchema(ctx context.Context) schema.Schema {
 type RoleModel struct {
 	Description       types.String     `tfsdk:"description"`
 	Id                types.Int64      `tfsdk:"id"`
+	IgnoreCase        types.Bool       `tfsdk:"ignore_case"`
 	LandingUrl        types.String     `tfsdk:"landing_url"`
+	MostRecent        types.Bool       `tfsdk:"most_recent"`
 	Multitenant       types.Bool       `tfsdk:"multitenant"`
 	MultitenantLocked types.Bool       `tfsdk:"multitenant_locked"`
 	Name              types.String     `tfsdk:"name"`
+	NameRegex         types.String     `tfsdk:"name_regex"`
 	Permissions       PermissionsValue `tfsdk:"permissions"`
 	RoleType          types.String     `tfsdk:"role_type"`
 }
@@ -0,0 +1,148 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package roles_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^ops-\"" RoleType "\"user\""
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const rolesListJSON = `{
+    "roles": [
+        {
+            "id": 1,
+            "name": "ops-admin",
+            "description": "Operations admin",
+            "roleType": "user",
+            "multitenant": false,
+            "landingUrl": "/operations"
+        },
+        {
+            "id": 2,
+            "name": "ops-viewer",
+            "roleType": "user",
+            "multitenant": true
+        },
+        {
+            "id": 3,
+            "name": "dev",
+            "roleType": "user"
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 3, "total": 3}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestRolesDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://roles.test").
+		Get("/api/roles($)").
+		MatchParam("roleType", "user").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(rolesListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://roles.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^ops-"`,
+		"RoleType", `"user"`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_roles.example",
+						"roles.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_roles.example",
+						"roles.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_roles.example",
+						"roles.0.landing_url",
+						"/operations",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_roles.example",
+						"roles.1.name",
+						"ops-viewer",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_roles.example",
+						"roles.1.multitenant",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,4 @@
+data "hpe_morpheus_roles" "example" {
+  name_regex = "^ops-"
+  role_type  = "user"
+}
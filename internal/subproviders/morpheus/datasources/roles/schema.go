@@ -0,0 +1,89 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package roles
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/role/consts"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func RolesDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Roles matching the given filters",
+		MarkdownDescription: "Roles matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("roles"),
+			"role_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return roles of this type",
+				MarkdownDescription: "Only return roles of this type",
+				Validators: []validator.String{
+					stringvalidator.OneOf(consts.RoleTypeUser, consts.RoleTypeAccount),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching roles",
+				MarkdownDescription: "Matching roles",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the role",
+							MarkdownDescription: "The ID of the role",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the role",
+							MarkdownDescription: "The name of the role",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the role",
+							MarkdownDescription: "The description of the role",
+						},
+						"role_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the role",
+							MarkdownDescription: "The type of the role",
+						},
+						"multitenant": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the role is inherited by subtenants",
+							MarkdownDescription: "Whether the role is inherited by subtenants",
+						},
+						"landing_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The landing page override for users with the role",
+							MarkdownDescription: "The landing page override for users with the role",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type RolesModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	RoleType  types.String `tfsdk:"role_type"`
+	Roles     []RoleModel  `tfsdk:"roles"`
+}
+
+type RoleModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	RoleType    types.String `tfsdk:"role_type"`
+	Multitenant types.Bool   `tfsdk:"multitenant"`
+	LandingUrl  types.String `tfsdk:"landing_url"`
+}
@@ -16,12 +16,14 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	internalErrors "github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
 )
 
 const (
 	summary                 = "read service plan data source"
 	ErrorNoServicePlanFound = `no service plan found`
-	ErrorNoValidSearchTerms = "no valid search terms - an id or (name and provision_type_code) " +
+	ErrorNoValidSearchTerms = "no valid search terms - an id or (name or name_regex, and provision_type_code) " +
 		"is required"
 	ErrorRunningPreApply      = `Error running pre-apply plan: exit status 1`
 	ErrorMultipleServicePlans = `multiple service plans were returned`
@@ -79,25 +81,45 @@ func getServicePlanByID(
 	return servicePlan, nil
 }
 
+type provisionType = sdk.
+	GetInstanceTypeProvisioning200ResponseAllOfInstanceTypeInstanceTypeLayoutsInnerProvisionType
+
 func getServicePlanByName(
 	ctx context.Context,
-	name string,
-	provisionTypeCode string,
+	data ServicePlanModel,
 	apiClient *sdk.APIClient,
 ) (*sdk.GetServicePlans200ResponseServicePlan, error) {
-	pTypes, hresp, err := apiClient.ProvisioningAPI.ListProvisionTypes(ctx).Code(
-		provisionTypeCode).Execute()
-	if pTypes == nil || err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET failed for service plan , provision type code %s: %s",
-			provisionTypeCode, internalErrors.ErrMsg(err, hresp))
-	}
+	provisionTypeCode := data.ProvisionTypeCode.ValueString()
 
-	var matchingProvisionTypes []sdk.
-		GetInstanceTypeProvisioning200ResponseAllOfInstanceTypeInstanceTypeLayoutsInnerProvisionType
-	for _, pt := range pTypes.GetProvisionTypes() {
-		if ptCode, ok := pt.GetCodeOk(); ok && *ptCode == provisionTypeCode {
-			matchingProvisionTypes = append(matchingProvisionTypes, pt)
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
+	}
+	name, exact := m.ExactName()
+
+	matchingProvisionTypes, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]provisionType, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		pTypes, hresp, err := apiClient.ProvisioningAPI.ListProvisionTypes(ctx).
+			Code(provisionTypeCode).
+			Max(pageSize).
+			Offset(offset).
+			Execute()
+		if pTypes == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for service plan , provision type code %s: %s",
+				provisionTypeCode, internalErrors.ErrMsg(err, hresp))
 		}
+
+		return pTypes.GetProvisionTypes(), pTypes.Meta, nil
+	}, func(pt provisionType) bool {
+		ptCode, ok := pt.GetCodeOk()
+
+		return ok && *ptCode == provisionTypeCode
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if len(matchingProvisionTypes) == 0 {
@@ -113,37 +135,60 @@ func getServicePlanByName(
 		return nil, fmt.Errorf("id not found for provision type with code %s", provisionTypeCode)
 	}
 
-	ps, hresp, err := apiClient.ServicePlansAPI.ListServicePlans(ctx).Name(
-		name).ProvisionTypeId(*pTypeID).Execute()
-	if ps == nil || err != nil || hresp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"GET failed for service_plan %s: %s", name, internalErrors.ErrMsg(err, hresp))
-	}
-
-	var matchingServicePlans []sdk.ListServicePlans200ResponseAllOfServicePlansInner
+	matchingServicePlans, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.ListServicePlans200ResponseAllOfServicePlansInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		req := apiClient.ServicePlansAPI.ListServicePlans(ctx).
+			ProvisionTypeId(*pTypeID).
+			Max(pageSize).
+			Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
 
-	for _, sp := range ps.GetServicePlans() {
-		if pName, pNameOk := sp.GetNameOk(); pNameOk {
-			if pProvisionType, pProvisionTypeOk := sp.GetProvisionTypeOk(); pProvisionTypeOk {
-				// now check name and ProvisionType match getplanByName() params
-				if *pName == name && pProvisionType.GetCode() == provisionTypeCode {
-					matchingServicePlans = append(matchingServicePlans, sp)
-				}
-			}
+		ps, hresp, err := req.Execute()
+		if ps == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"GET failed for service_plan with %s: %s", m, internalErrors.ErrMsg(err, hresp))
 		}
+
+		return ps.GetServicePlans(), ps.Meta, nil
+	}, func(sp sdk.ListServicePlans200ResponseAllOfServicePlansInner) bool {
+		pName, pNameOk := sp.GetNameOk()
+		pProvisionType, pProvisionTypeOk := sp.GetProvisionTypeOk()
+
+		// now check name and ProvisionType match getplanByName() params
+		return pNameOk && pProvisionTypeOk &&
+			m.Match(*pName) && pProvisionType.GetCode() == provisionTypeCode
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := namematch.Select(m, matchingServicePlans,
+		func(sp sdk.ListServicePlans200ResponseAllOfServicePlansInner) namematch.Candidate {
+			return namematch.Candidate{Id: sp.GetId(), Name: sp.GetName(), DateCreated: sp.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		ErrorNoServicePlanFound,
+		ErrorMultipleServicePlans,
+	)
+	if err != nil {
+		return nil, err
 	}
-	if len(matchingServicePlans) == 1 {
-		if pID, pIDOk := matchingServicePlans[0].GetIdOk(); pIDOk {
-			// same return types as GetPlanByID
-			return getServicePlanByID(ctx, *pID, apiClient)
-		}
 
-		return nil, fmt.Errorf("service plan %s, id not found", name)
-	} else if len(matchingServicePlans) > 1 {
-		return nil, errors.New(ErrorMultipleServicePlans)
+	if pID, pIDOk := plan.GetIdOk(); pIDOk {
+		// same return types as GetPlanByID
+		return getServicePlanByID(ctx, *pID, apiClient)
 	}
 
-	return nil, errors.New(ErrorNoServicePlanFound)
+	return nil, fmt.Errorf("service plan %s, id not found", plan.GetName())
 }
 
 func getServicePlan(
@@ -153,9 +198,9 @@ func getServicePlan(
 ) (*sdk.GetServicePlans200ResponseServicePlan, error) {
 	if !data.Id.IsNull() {
 		return getServicePlanByID(ctx, data.Id.ValueInt64(), apiClient)
-	} else if !data.Name.IsNull() && !data.ProvisionTypeCode.IsNull() {
-		return getServicePlanByName(
-			ctx, data.Name.ValueString(), data.ProvisionTypeCode.ValueString(), apiClient)
+	} else if (!data.Name.IsNull() || !data.NameRegex.IsNull()) &&
+		!data.ProvisionTypeCode.IsNull() {
+		return getServicePlanByName(ctx, data, apiClient)
 	}
 
 	return nil, errors.New(ErrorNoValidSearchTerms)
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
 )
 
 func ServicePlanDataSourceSchema(ctx context.Context) schema.Schema {
@@ -36,9 +38,20 @@ func ServicePlanDataSourceSchema(ctx context.Context) schema.Schema {
 					int64validator.ConflictsWith(path.Expressions{
 						path.MatchRoot("name"),
 						path.MatchRoot("provision_type_code"),
+						path.MatchRoot("name_regex"),
 					}...),
 				},
 			},
+			"ignore_case": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Match name or name_regex case-insensitively",
+				MarkdownDescription: "Match `name` or `name_regex` case-insensitively",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If more than one service plan matches, use the most recently created one",
+				MarkdownDescription: "If more than one service plan matches, use the most recently created one",
+			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -48,19 +61,32 @@ func ServicePlanDataSourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("provision_type_code"),
 					}...),
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("name_regex"),
+					}...),
 				},
 			},
-			"provision_type_code": schema.StringAttribute{
+			"name_regex": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "The provision type code of the Morpheus service plan",
-				MarkdownDescription: "The provision type code of the Morpheus service plan",
+				Description:         "A regular expression matching the name of the Morpheus service plan",
+				MarkdownDescription: "A regular expression matching the name of the Morpheus service plan",
 				Validators: []validator.String{
-					stringvalidator.AlsoRequires(path.Expressions{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("id"),
 						path.MatchRoot("name"),
 					}...),
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("provision_type_code"),
+					}...),
+					morpheusvalidators.RegexValidator{},
 				},
 			},
+			"provision_type_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The provision type code of the Morpheus service plan",
+				MarkdownDescription: "The provision type code of the Morpheus service plan",
+			},
 		},
 	}
 }
@@ -69,6 +95,9 @@ type ServicePlanModel struct {
 	Code              types.String `tfsdk:"code"`
 	Description       types.String `tfsdk:"description"`
 	Id                types.Int64  `tfsdk:"id"`
+	IgnoreCase        types.Bool   `tfsdk:"ignore_case"`
+	MostRecent        types.Bool   `tfsdk:"most_recent"`
 	Name              types.String `tfsdk:"name"`
+	NameRegex         types.String `tfsdk:"name_regex"`
 	ProvisionTypeCode types.String `tfsdk:"provision_type_code"`
 }
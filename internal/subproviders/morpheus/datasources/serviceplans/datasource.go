@@ -0,0 +1,159 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package serviceplans
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const summary = "read service plans data source"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &DataSource{}
+)
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_service_plans"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ServicePlansDataSourceSchema(ctx)
+}
+
+func listServicePlans(
+	ctx context.Context,
+	apiClient *sdk.APIClient,
+) ([]sdk.ListServicePlans200ResponseAllOfServicePlansInner, error) {
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.ListServicePlans200ResponseAllOfServicePlansInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		ps, hresp, err := apiClient.ServicePlansAPI.ListServicePlans(ctx).
+			Max(pageSize).
+			Offset(offset).
+			Execute()
+		if ps == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"GET failed for service plans: %s", errors.ErrMsg(err, hresp),
+			)
+		}
+
+		return ps.GetServicePlans(), ps.Meta, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ServicePlansModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := filter.New(data.NameRegex, types.SetNull(types.StringType), data.Visibility)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	plans, err := listServicePlans(ctx, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.ServicePlans = []ServicePlanModel{}
+	for _, plan := range plans {
+		if !f.MatchName(plan.GetName()) || !f.MatchVisibility(plan.GetVisibility()) {
+			continue
+		}
+
+		provisionTypeCode := types.StringNull()
+		if pt, ok := plan.GetProvisionTypeOk(); ok {
+			provisionTypeCode = convert.StrToType(pt.Code)
+		}
+
+		if !data.ProvisionTypeCode.IsNull() &&
+			!data.ProvisionTypeCode.Equal(provisionTypeCode) {
+			continue
+		}
+
+		data.ServicePlans = append(data.ServicePlans, ServicePlanModel{
+			Id:                convert.Int64ToType(plan.Id),
+			Name:              convert.StrToType(plan.Name),
+			Code:              convert.StrToType(plan.Code),
+			Description:       convert.StrToType(plan.Description),
+			Active:            convert.BoolToType(plan.Active),
+			Visibility:        convert.StrToType(plan.Visibility),
+			ProvisionTypeCode: provisionTypeCode,
+			SortOrder:         convert.Int64ToType(plan.SortOrder),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,151 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package serviceplans_test
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl NameRegex "\"^small\"" ProvisionTypeCode "\"vmware\""
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const servicePlansListJSON = `{
+    "servicePlans": [
+        {
+            "id": 1,
+            "name": "small-vmware",
+            "code": "small-vmware",
+            "description": "Small",
+            "active": true,
+            "visibility": "public",
+            "sortOrder": 1,
+            "provisionType": {"id": 1, "name": "VMware", "code": "vmware"}
+        },
+        {
+            "id": 2,
+            "name": "small-aws",
+            "code": "small-aws",
+            "active": true,
+            "visibility": "public",
+            "sortOrder": 2,
+            "provisionType": {"id": 2, "name": "Amazon", "code": "amazon"}
+        },
+        {
+            "id": 3,
+            "name": "large-vmware",
+            "code": "large-vmware",
+            "active": true,
+            "visibility": "public",
+            "sortOrder": 3,
+            "provisionType": {"id": 1, "name": "VMware", "code": "vmware"}
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 3, "total": 3}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestServicePlansDataSourceFilter(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://serviceplans.test").
+		Get("/api/service-plans($)").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(servicePlansListJSON)
+
+	providerConfig := `
+provider "hpe" {
+	morpheus {
+		url = "http://serviceplans.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"NameRegex", `"^small"`,
+		"ProvisionTypeCode", `"vmware"`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_service_plans.example",
+						"service_plans.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_service_plans.example",
+						"service_plans.0.id",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_service_plans.example",
+						"service_plans.0.code",
+						"small-vmware",
+					),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_service_plans.example",
+						"service_plans.0.provision_type_code",
+						"vmware",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,4 @@
+data "hpe_morpheus_service_plans" "example" {
+  name_regex          = "^small"
+  provision_type_code = "vmware"
+}
@@ -0,0 +1,97 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package serviceplans
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func ServicePlansDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Service plans matching the given filters",
+		MarkdownDescription: "Service plans matching the given filters",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": filter.NameRegexAttribute("service plans"),
+			"visibility": filter.VisibilityAttribute("service plans"),
+			"provision_type_code": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return service plans for this provision type code",
+				MarkdownDescription: "Only return service plans for this provision type code",
+			},
+			"service_plans": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching service plans",
+				MarkdownDescription: "Matching service plans",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the service plan",
+							MarkdownDescription: "The ID of the service plan",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the service plan",
+							MarkdownDescription: "The name of the service plan",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the service plan",
+							MarkdownDescription: "The code of the service plan",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the service plan",
+							MarkdownDescription: "The description of the service plan",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the service plan is active",
+							MarkdownDescription: "Whether the service plan is active",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Visibility of the service plan",
+							MarkdownDescription: "Visibility of the service plan",
+						},
+						"provision_type_code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the provision type of the service plan",
+							MarkdownDescription: "The code of the provision type of the service plan",
+						},
+						"sort_order": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The sort order of the service plan",
+							MarkdownDescription: "The sort order of the service plan",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type ServicePlansModel struct {
+	NameRegex         types.String       `tfsdk:"name_regex"`
+	Visibility        types.String       `tfsdk:"visibility"`
+	ProvisionTypeCode types.String       `tfsdk:"provision_type_code"`
+	ServicePlans      []ServicePlanModel `tfsdk:"service_plans"`
+}
+
+type ServicePlanModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Code              types.String `tfsdk:"code"`
+	Description       types.String `tfsdk:"description"`
+	Active            types.Bool   `tfsdk:"active"`
+	Visibility        types.String `tfsdk:"visibility"`
+	ProvisionTypeCode types.String `tfsdk:"provision_type_code"`
+	SortOrder         types.Int64  `tfsdk:"sort_order"`
+}
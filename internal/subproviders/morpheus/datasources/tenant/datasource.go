@@ -0,0 +1,186 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:generate go run ../../../../../cmd/render example-id.tf.tmpl Id 99
+//go:generate go run ../../../../../cmd/render example-name.tf.tmpl Name "Example name"
+
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+const (
+	summary                 = "read tenant data source"
+	ErrorNoValidSearchTerms = `no valid search terms - an id, name or name_regex is required`
+	ErrorNoTenantFound      = `no tenant found`
+	ErrorMultipleTenants    = `multiple tenants were returned`
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &DataSource{}
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the data source implementation.
+type DataSource struct {
+	configure.DataSourceWithMorpheusConfigure
+	datasource.DataSource
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_tenant"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = TenantDataSourceSchema(ctx)
+}
+
+func getTenantByID(
+	ctx context.Context,
+	id int64,
+	apiClient *sdk.APIClient,
+) (*sdk.ListTenants200ResponseAllOfAccountsInner, error) {
+	t, hresp, err := apiClient.TenantsAPI.GetTenant(ctx, id).Execute()
+	if t == nil || err != nil || hresp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET failed for tenant %d", id)
+	}
+
+	tenant := t.GetAccount()
+
+	return &tenant, nil
+}
+
+func getTenantByName(
+	ctx context.Context,
+	data TenantModel,
+	apiClient *sdk.APIClient,
+) (*sdk.ListTenants200ResponseAllOfAccountsInner, error) {
+	m, err := namematch.New(data.Name, data.NameRegex, data.IgnoreCase)
+	if err != nil {
+		return nil, err
+	}
+	name, exact := m.ExactName()
+
+	tenants, err := paginate.Filter(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) (
+		[]sdk.ListTenants200ResponseAllOfAccountsInner,
+		*sdk.ListActivity200ResponseAllOfMeta,
+		error,
+	) {
+		req := apiClient.TenantsAPI.ListTenants(ctx).Max(pageSize).Offset(offset)
+		if exact {
+			req = req.Name(name)
+		}
+
+		ts, hresp, err := req.Execute()
+		if ts == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("GET failed for tenant with %s", m)
+		}
+
+		return ts.GetAccounts(), ts.Meta, nil
+	}, func(t sdk.ListTenants200ResponseAllOfAccountsInner) bool {
+		return m.Match(t.GetName())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namematch.Select(m, tenants,
+		func(t sdk.ListTenants200ResponseAllOfAccountsInner) namematch.Candidate {
+			return namematch.Candidate{Id: t.GetId(), Name: t.GetName(), DateCreated: t.DateCreated}
+		},
+		data.MostRecent.ValueBool(),
+		ErrorNoTenantFound,
+		ErrorMultipleTenants,
+	)
+}
+
+func getTenant(
+	ctx context.Context,
+	data TenantModel,
+	apiClient *sdk.APIClient,
+) (*sdk.ListTenants200ResponseAllOfAccountsInner, error) {
+	if !data.Id.IsNull() {
+		return getTenantByID(ctx, data.Id.ValueInt64(), apiClient)
+	} else if !data.Name.IsNull() || !data.NameRegex.IsNull() {
+		return getTenantByName(ctx, data, apiClient)
+	}
+
+	return nil, errors.New(ErrorNoValidSearchTerms)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data TenantModel
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := d.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			"could not create sdk client",
+		)
+
+		return
+	}
+
+	tenant, err := getTenant(ctx, data, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			summary,
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.Id = convert.Int64ToType(tenant.Id)
+	data.Name = convert.StrToType(tenant.Name)
+	data.Description = convert.StrToType(tenant.Description.Get())
+	data.Subdomain = convert.StrToType(tenant.Subdomain)
+	data.Currency = convert.StrToType(tenant.Currency)
+	data.AccountNumber = convert.StrToType(tenant.AccountNumber.Get())
+	data.Active = convert.BoolToType(tenant.Active)
+	data.RoleId = convert.Int64ToType(tenant.GetRole().Id)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
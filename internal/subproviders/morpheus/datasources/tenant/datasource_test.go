@@ -0,0 +1,168 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package tenant_test
+
+import (
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/tenant"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+const providerConfig = `
+provider "hpe" {
+	morpheus {
+		url = "http://tenant.test"
+		access_token = "abc123"
+		insecure = true
+	}
+}
+`
+
+const tenantsListJSON = `{
+    "accounts": [
+        {
+            "id": 2,
+            "name": "Customer A",
+            "description": "first customer",
+            "subdomain": "customera",
+            "currency": "USD",
+            "accountNumber": "A-001",
+            "active": true,
+            "role": {"id": 7, "authority": "Tenant Admin"},
+            "dateCreated": "2025-01-01T00:00:00Z"
+        },
+        {
+            "id": 3,
+            "name": "customer a",
+            "subdomain": "customera2",
+            "currency": "EUR",
+            "active": false,
+            "role": {"id": 7, "authority": "Tenant Admin"},
+            "dateCreated": "2025-02-01T00:00:00Z"
+        }
+    ],
+    "meta": {"offset": 0, "max": 100, "size": 2, "total": 2}
+}`
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+
+	clientFactoryFunc := func(m model.SubModel) *clientfactory.ClientFactory {
+		return clientfactory.New(
+			m,
+			clientfactory.WithFactoryHTTPClient(httpClient),
+		)
+	}
+
+	providerInstance := provider.New(
+		"test",
+		morpheus.New(morpheus.WithClientFactory(clientFactoryFunc)),
+	)()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer,
+	error,
+){
+	"hpe": newProviderWithError,
+}
+
+func TestTenantDataSourceByName(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://tenant.test").
+		Get("/api/accounts($)").
+		MatchParam("name", "Customer A").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(tenantsListJSON)
+
+	dataSourceConfig, err := testhelpers.RenderExample(t, "example-name.tf.tmpl",
+		"Name", "Customer A")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_tenant.test", "id", "2"),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_tenant.test", "subdomain", "customera"),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_tenant.test", "currency", "USD"),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_tenant.test", "account_number", "A-001"),
+					resource.TestCheckResourceAttr(
+						"data.hpe_morpheus_tenant.test", "role_id", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestTenantDataSourceIgnoreCase(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	defer gock.Off()
+
+	gock.New("http://tenant.test").
+		Get("/api/accounts($)").
+		Persist().
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		JSON(tenantsListJSON)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "hpe_morpheus_tenant" "test" {
+  name        = "CUSTOMER A"
+  ignore_case = true
+}`,
+				ExpectError: regexp.MustCompile(tenant.ErrorMultipleTenants),
+			},
+			{
+				Config: providerConfig + `
+data "hpe_morpheus_tenant" "test" {
+  name        = "CUSTOMER A"
+  ignore_case = true
+  most_recent = true
+}`,
+				Check: resource.TestCheckResourceAttr(
+					"data.hpe_morpheus_tenant.test", "id", "3"),
+			},
+		},
+	})
+}
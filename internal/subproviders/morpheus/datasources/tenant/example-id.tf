@@ -0,0 +1,3 @@
+data "hpe_morpheus_tenant" "test" {
+  id = 99
+}
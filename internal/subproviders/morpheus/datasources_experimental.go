@@ -21,13 +21,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/cloud"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/clouds"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/environment"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/environments"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/group"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/groups"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/instancetypelayout"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/instancetypelayouts"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/network"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/networks"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/role"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/rolepermissions"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/roles"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/serviceplan"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/serviceplans"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/datasources/tenant"
 )
 
 func (SubProvider) GetDataSources(
@@ -35,12 +43,20 @@ func (SubProvider) GetDataSources(
 ) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		cloud.NewDataSource,
+		clouds.NewDataSource,
 		environment.NewDataSource,
+		environments.NewDataSource,
 		group.NewDataSource,
+		groups.NewDataSource,
 		instancetypelayout.NewDataSource,
+		instancetypelayouts.NewDataSource,
 		network.NewDataSource,
+		networks.NewDataSource,
 		role.NewDataSource,
+		roles.NewDataSource,
 		rolepermissions.NewDataSource,
 		serviceplan.NewDataSource,
+		serviceplans.NewDataSource,
+		tenant.NewDataSource,
 	}
 }
@@ -0,0 +1,118 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package filter contains the filter attributes and the client side matching
+// shared by the plural data sources
+package filter
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
+)
+
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+func NameRegexAttribute(kind string) schema.StringAttribute {
+	desc := "Only return " + kind + " whose name matches this regular expression"
+
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         desc,
+		MarkdownDescription: desc,
+		Validators: []validator.String{
+			morpheusvalidators.RegexValidator{},
+		},
+	}
+}
+
+func LabelsAttribute(kind string) schema.SetAttribute {
+	desc := "Only return " + kind + " that have all of these labels"
+
+	return schema.SetAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Description:         desc,
+		MarkdownDescription: desc,
+	}
+}
+
+func VisibilityAttribute(kind string) schema.StringAttribute {
+	desc := "Only return " + kind + " with this visibility (public or private)"
+	mdesc := "Only return " + kind + " with this visibility " +
+		"(`public` or `private`)"
+
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         desc,
+		MarkdownDescription: mdesc,
+		Validators: []validator.String{
+			stringvalidator.OneOf(VisibilityPublic, VisibilityPrivate),
+		},
+	}
+}
+
+// Filter holds the parsed filter attributes of a plural data source. Unset
+// attributes match everything.
+type Filter struct {
+	nameRegex  *regexp.Regexp
+	labels     []string
+	visibility string
+}
+
+// New parses the filter attributes. Data sources without a labels or
+// visibility filter pass null values.
+func New(nameRegex types.String, labels types.Set, visibility types.String) (Filter, error) {
+	var f Filter
+	var err error
+
+	if !nameRegex.IsNull() {
+		f.nameRegex, err = regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			return f, err
+		}
+	}
+
+	if !labels.IsNull() {
+		f.labels, err = convert.SetToStrSlice(labels)
+		if err != nil {
+			return f, err
+		}
+	}
+
+	f.visibility = visibility.ValueString()
+
+	return f, nil
+}
+
+func (f Filter) MatchName(name string) bool {
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+func (f Filter) MatchLabels(labels []string) bool {
+	for _, l := range f.labels {
+		if !slices.Contains(labels, l) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f Filter) MatchVisibility(visibility string) bool {
+	return f.visibility == "" || f.visibility == visibility
+}
+
+// Match is a convenience for types that have a name, labels and visibility
+func (f Filter) Match(name string, labels []string, visibility string) bool {
+	return f.MatchName(name) && f.MatchLabels(labels) && f.MatchVisibility(visibility)
+}
@@ -0,0 +1,108 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/filter"
+)
+
+func labels(ls ...string) types.Set {
+	var elems []attr.Value
+	for _, l := range ls {
+		elems = append(elems, types.StringValue(l))
+	}
+
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		nameRegex  types.String
+		labels     types.Set
+		visibility types.String
+		matches    []string
+	}{
+		{
+			name:       "no filters",
+			nameRegex:  types.StringNull(),
+			labels:     types.SetNull(types.StringType),
+			visibility: types.StringNull(),
+			matches:    []string{"prod-a", "prod-b", "dev-a"},
+		},
+		{
+			name:       "name regex",
+			nameRegex:  types.StringValue("^prod-"),
+			labels:     types.SetNull(types.StringType),
+			visibility: types.StringNull(),
+			matches:    []string{"prod-a", "prod-b"},
+		},
+		{
+			name:       "all labels required",
+			nameRegex:  types.StringNull(),
+			labels:     labels("team-a", "east"),
+			visibility: types.StringNull(),
+			matches:    []string{"prod-a"},
+		},
+		{
+			name:       "visibility",
+			nameRegex:  types.StringNull(),
+			labels:     types.SetNull(types.StringType),
+			visibility: types.StringValue(filter.VisibilityPublic),
+			matches:    []string{"dev-a"},
+		},
+		{
+			name:       "combined",
+			nameRegex:  types.StringValue("-a$"),
+			labels:     labels("team-a"),
+			visibility: types.StringValue(filter.VisibilityPrivate),
+			matches:    []string{"prod-a"},
+		},
+	}
+
+	items := []struct {
+		name       string
+		labels     []string
+		visibility string
+	}{
+		{"prod-a", []string{"team-a", "east"}, filter.VisibilityPrivate},
+		{"prod-b", []string{"team-b"}, filter.VisibilityPrivate},
+		{"dev-a", []string{"team-a"}, filter.VisibilityPublic},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := filter.New(tc.nameRegex, tc.labels, tc.visibility)
+			assert.NoError(t, err)
+
+			var matches []string
+			for _, item := range items {
+				if f.Match(item.name, item.labels, item.visibility) {
+					matches = append(matches, item.name)
+				}
+			}
+			assert.Equal(t, tc.matches, matches)
+		})
+	}
+}
+
+func TestFilterInvalidRegex(t *testing.T) {
+	t.Parallel()
+
+	_, err := filter.New(
+		types.StringValue("("),
+		types.SetNull(types.StringType),
+		types.StringNull(),
+	)
+	assert.Error(t, err)
+}
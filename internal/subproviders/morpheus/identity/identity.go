@@ -0,0 +1,48 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package identity provides the resource identity shared by Morpheus
+// resources that are addressed by a single numeric id
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Model struct {
+	Id types.Int64 `tfsdk:"id"`
+}
+
+func Schema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the resource",
+			},
+		},
+	}
+}
+
+// ImportState copies the id from the import identity into state. It is
+// used when an import block specifies an identity rather than an id.
+func ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var m Model
+
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), m.Id)...,
+	)
+}
@@ -0,0 +1,27 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build !experimental
+
+// This file is used to include the Morpheus subprovider list resources in the
+// release build. It is not used in the experimental build. When building the
+// experimental version, use the `-tags experimental` flag to exclude this
+// file.
+
+// When list resources are ready for production use, they should be moved to
+// this file
+
+package morpheus
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func (s SubProvider) GetListResources(
+	_ context.Context,
+) []func() list.ListResource {
+	listResources := []func() list.ListResource{}
+
+	return listResources
+}
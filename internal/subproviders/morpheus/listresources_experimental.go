@@ -0,0 +1,39 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+// This file is used to include experimental list resources in the Morpheus
+// subprovider. It is not included in the release build. It is not intended
+// for production use and may contain unstable or incomplete features.
+
+// When building the provider, use the `-tags experimental` flag to include
+// this file.
+
+// When list resources are ready for production use, they should be moved to
+// the `listresources.go` file.
+
+package morpheus
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/group"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/network"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/role"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/user"
+)
+
+func (s SubProvider) GetListResources(
+	_ context.Context,
+) []func() list.ListResource {
+	listResources := []func() list.ListResource{
+		group.NewListResource,
+		network.NewListResource,
+		user.NewListResource,
+		role.NewListResource,
+	}
+
+	return listResources
+}
@@ -0,0 +1,59 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package listresult contains helpers shared by the Morpheus list resources
+package listresult
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// New builds the list result for the object with the given id. The full
+// resource is only read, via getState, when terraform asks for it.
+func New[M any](
+	ctx context.Context,
+	req list.ListRequest,
+	id int64,
+	displayName string,
+	getState func() (M, diag.Diagnostics),
+) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	result.Diagnostics.Append(
+		result.Identity.Set(ctx, identity.Model{Id: types.Int64Value(id)})...,
+	)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	state, diags := getState()
+	result.Diagnostics.Append(diags...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+
+	return result
+}
+
+// Error returns a list result that only carries an error diagnostic, used
+// to abort the listing
+func Error(summary, detail string) list.ListResult {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+
+	return list.ListResult{Diagnostics: diags}
+}
+
+// LimitReached reports whether count results satisfy the request limit
+func LimitReached(req list.ListRequest, count int64) bool {
+	return req.Limit > 0 && count >= req.Limit
+}
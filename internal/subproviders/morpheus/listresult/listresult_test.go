@@ -0,0 +1,28 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package listresult_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/listresult"
+)
+
+func TestLimitReached(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, listresult.LimitReached(list.ListRequest{}, 1000))
+	assert.False(t, listresult.LimitReached(list.ListRequest{Limit: 10}, 9))
+	assert.True(t, listresult.LimitReached(list.ListRequest{Limit: 10}, 10))
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	r := listresult.Error("summary", "detail")
+	assert.True(t, r.Diagnostics.HasError())
+	assert.Equal(t, "summary", r.Diagnostics[0].Summary())
+}
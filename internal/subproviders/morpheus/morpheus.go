@@ -17,7 +17,11 @@ import (
 	"github.com/HPE/terraform-provider-hpe/subprovider"
 )
 
-var _ subprovider.SubProvider = (*SubProvider)(nil)
+var (
+	_ subprovider.SubProvider                  = (*SubProvider)(nil)
+	_ subprovider.SubProviderWithActions       = (*SubProvider)(nil)
+	_ subprovider.SubProviderWithListResources = (*SubProvider)(nil)
+)
 
 type Option func(*SubProvider)
 
@@ -0,0 +1,176 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package morpheusvalidators
+
+// This validator allows us to verify, at plan time, that a set of
+// ip ranges (objects with start_address and end_address attributes)
+// contains valid addresses and that no two ranges overlap, eg
+// this is ok
+//   ip_ranges = [
+//     { start_address = "10.0.0.10", end_address = "10.0.0.20" },
+//     { start_address = "10.0.0.30", end_address = "10.0.0.40" },
+//   ]
+// This would not pass validation
+//   ip_ranges = [
+//     { start_address = "10.0.0.10", end_address = "10.0.0.20" },
+//     { start_address = "10.0.0.15", end_address = "10.0.0.40" },
+//   ]
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.Set = IPRangesValidator{}
+
+type IPRangesValidator struct{}
+
+type IPRange struct {
+	Start netip.Addr
+	End   netip.Addr
+}
+
+func (r IPRange) String() string {
+	return r.Start.String() + "-" + r.End.String()
+}
+
+func (v IPRangesValidator) Description(context.Context) string {
+	return "verify that the ip ranges are valid and do not overlap"
+}
+
+func (v IPRangesValidator) MarkdownDescription(context.Context) string {
+	return "verify that the ip ranges are valid and do not overlap"
+}
+
+func (v IPRangesValidator) ValidateSet(
+	ctx context.Context,
+	request validator.SetRequest,
+	response *validator.SetResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var ranges []IPRange
+
+	for _, elem := range request.ConfigValue.Elements() {
+		objValuable, ok := elem.(basetypes.ObjectValuable)
+		if !ok {
+			continue
+		}
+
+		obj, diags := objValuable.ToObjectValue(ctx)
+		if diags.HasError() {
+			response.Diagnostics.Append(diags...)
+
+			return
+		}
+
+		start, startKnown := stringAttribute(obj, "start_address")
+		end, endKnown := stringAttribute(obj, "end_address")
+
+		// ranges containing unknown values are checked at apply time
+		if !startKnown || !endKnown {
+			continue
+		}
+
+		r, err := ParseIPRange(start, end)
+		if err != nil {
+			response.Diagnostics.Append(
+				diag.NewAttributeErrorDiagnostic(
+					request.Path,
+					"Invalid ip range",
+					err.Error(),
+				),
+			)
+
+			continue
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	if a, b, found := FindOverlap(ranges); found {
+		response.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				request.Path,
+				"Overlapping ip ranges",
+				fmt.Sprintf("ip range %s overlaps with ip range %s", a, b),
+			),
+		)
+	}
+}
+
+// stringAttribute returns the value of a string attribute of an object and
+// whether it is known
+func stringAttribute(obj basetypes.ObjectValue, name string) (string, bool) {
+	attr, ok := obj.Attributes()[name].(basetypes.StringValue)
+	if !ok || attr.IsNull() || attr.IsUnknown() {
+		return "", false
+	}
+
+	return attr.ValueString(), true
+}
+
+// ParseIPRange parses a start and end address into an IPRange, checking
+// both are of the same address family and that start is not after end
+func ParseIPRange(start string, end string) (IPRange, error) {
+	var r IPRange
+
+	s, err := netip.ParseAddr(start)
+	if err != nil {
+		return r, fmt.Errorf("start address %q is not a valid ip address", start)
+	}
+
+	e, err := netip.ParseAddr(end)
+	if err != nil {
+		return r, fmt.Errorf("end address %q is not a valid ip address", end)
+	}
+
+	s, e = s.Unmap(), e.Unmap()
+
+	if s.Is4() != e.Is4() {
+		return r, fmt.Errorf(
+			"start address %s and end address %s are not in the same address family", s, e)
+	}
+
+	if e.Less(s) {
+		return r, fmt.Errorf("start address %s is after end address %s", s, e)
+	}
+
+	r.Start = s
+	r.End = e
+
+	return r, nil
+}
+
+// FindOverlap returns the first pair of overlapping ranges, if any
+func FindOverlap(ranges []IPRange) (IPRange, IPRange, bool) {
+	sorted := make([]IPRange, len(ranges))
+	copy(sorted, ranges)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Less(sorted[j].Start)
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1], sorted[i]
+
+		// ipv4 addresses sort before ipv6, so ranges of different
+		// families never overlap
+		if prev.Start.Is4() != cur.Start.Is4() {
+			continue
+		}
+
+		if !prev.End.Less(cur.Start) {
+			return prev, cur, true
+		}
+	}
+
+	return IPRange{}, IPRange{}, false
+}
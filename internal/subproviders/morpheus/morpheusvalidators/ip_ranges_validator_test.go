@@ -0,0 +1,127 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package morpheusvalidators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/morpheusvalidators"
+)
+
+var rangeAttrTypes = map[string]attr.Type{
+	"start_address": types.StringType,
+	"end_address":   types.StringType,
+}
+
+func rangeSet(t *testing.T, ranges ...[2]attr.Value) types.Set {
+	t.Helper()
+
+	var elems []attr.Value
+	for _, r := range ranges {
+		elems = append(elems, types.ObjectValueMust(rangeAttrTypes, map[string]attr.Value{
+			"start_address": r[0],
+			"end_address":   r[1],
+		}))
+	}
+
+	set, diags := types.SetValue(types.ObjectType{AttrTypes: rangeAttrTypes}, elems)
+	if diags.HasError() {
+		t.Fatalf("building set: %v", diags)
+	}
+
+	return set
+}
+
+func str(s string) attr.Value {
+	return types.StringValue(s)
+}
+
+func TestIPRangesValidator(t *testing.T) {
+	cases := []struct {
+		name    string
+		ranges  [][2]attr.Value
+		wantErr bool
+	}{
+		{
+			name: "disjoint",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.10"), str("10.0.0.20")},
+				{str("10.0.0.21"), str("10.0.0.40")},
+			},
+		},
+		{
+			name: "overlapping",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.10"), str("10.0.0.20")},
+				{str("10.0.0.20"), str("10.0.0.40")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "contained",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.1"), str("10.0.0.100")},
+				{str("10.0.0.2"), str("10.0.0.3")},
+				{str("10.0.0.50"), str("10.0.0.60")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "mixed families",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.1"), str("10.0.0.100")},
+				{str("fd00::1"), str("fd00::ff")},
+			},
+		},
+		{
+			name: "start after end",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.20"), str("10.0.0.10")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid address",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.300"), str("10.0.0.310")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "different families in one range",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.1"), str("fd00::1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown skipped",
+			ranges: [][2]attr.Value{
+				{str("10.0.0.10"), str("10.0.0.20")},
+				{types.StringUnknown(), str("10.0.0.15")},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.SetRequest{
+				Path:        path.Root("ip_ranges"),
+				ConfigValue: rangeSet(t, tc.ranges...),
+			}
+			resp := &validator.SetResponse{}
+
+			morpheusvalidators.IPRangesValidator{}.ValidateSet(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Errorf("expected error %v, got %v: %v", tc.wantErr, got, resp.Diagnostics)
+			}
+		})
+	}
+}
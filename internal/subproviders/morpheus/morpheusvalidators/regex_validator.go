@@ -0,0 +1,46 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package morpheusvalidators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = RegexValidator{}
+
+type RegexValidator struct{}
+
+func (c RegexValidator) Description(context.Context) string {
+	return "verify that the attribute is a valid regular expression"
+}
+
+func (c RegexValidator) MarkdownDescription(context.Context) string {
+	return "verify that the attribute is a valid regular expression"
+}
+
+func (c RegexValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := regexp.Compile(value); err != nil {
+		response.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				request.Path,
+				"not a valid regular expression",
+				"attribute must contain a valid RE2 regular expression: "+
+					err.Error(),
+			),
+		)
+	}
+}
@@ -0,0 +1,170 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package namematch contains the name, name_regex, ignore_case and
+// most_recent lookup logic shared by the singular data sources
+package namematch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Matcher matches object names against the name or name_regex of a data
+// source config
+type Matcher struct {
+	name       *string
+	nameRegex  *regexp.Regexp
+	ignoreCase bool
+}
+
+// New builds a Matcher from the data source config. name takes precedence
+// over nameRegex when both are set.
+func New(name types.String, nameRegex types.String, ignoreCase types.Bool) (Matcher, error) {
+	m := Matcher{
+		ignoreCase: ignoreCase.ValueBool(),
+	}
+
+	if !name.IsNull() {
+		m.name = name.ValueStringPointer()
+
+		return m, nil
+	}
+
+	if !nameRegex.IsNull() {
+		expr := nameRegex.ValueString()
+		if m.ignoreCase {
+			expr = "(?i)" + expr
+		}
+
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return m, fmt.Errorf("invalid name_regex: %w", err)
+		}
+		m.nameRegex = re
+	}
+
+	return m, nil
+}
+
+// IsSet reports whether the config has a name or name_regex to match
+func (m Matcher) IsSet() bool {
+	return m.name != nil || m.nameRegex != nil
+}
+
+// ExactName returns the name to use as an API side filter. This is only
+// possible for a case sensitive lookup by name, otherwise every object has
+// to be listed and matched with Match.
+func (m Matcher) ExactName() (string, bool) {
+	if m.name == nil || m.ignoreCase {
+		return "", false
+	}
+
+	return *m.name, true
+}
+
+// Match reports whether name satisfies the config
+func (m Matcher) Match(name string) bool {
+	switch {
+	case m.nameRegex != nil:
+		return m.nameRegex.MatchString(name)
+	case m.name == nil:
+		return false
+	case m.ignoreCase:
+		return strings.EqualFold(*m.name, name)
+	default:
+		return *m.name == name
+	}
+}
+
+// String describes the lookup for error messages
+func (m Matcher) String() string {
+	var s string
+	if m.nameRegex != nil {
+		s = fmt.Sprintf("name_regex %q", m.nameRegex.String())
+	} else if m.name != nil {
+		s = fmt.Sprintf("name %q", *m.name)
+	}
+
+	if m.ignoreCase {
+		s += " (ignoring case)"
+	}
+
+	return s
+}
+
+// Candidate describes a matching object
+type Candidate struct {
+	Id          int64
+	Name        string
+	DateCreated *time.Time
+}
+
+// latestIndex returns the index of the most recently created candidate. The
+// creation dates decide if every candidate has one, otherwise the ids do
+// as they increase over time.
+func latestIndex(candidates []Candidate) int {
+	byDate := !slices.ContainsFunc(candidates, func(c Candidate) bool {
+		return c.DateCreated == nil
+	})
+
+	latest := 0
+	for i, c := range candidates {
+		l := candidates[latest]
+
+		newer := c.Id > l.Id
+		if byDate && !c.DateCreated.Equal(*l.DateCreated) {
+			newer = c.DateCreated.After(*l.DateCreated)
+		}
+		if newer {
+			latest = i
+		}
+	}
+
+	return latest
+}
+
+// Select returns the single object in matches. If there are several and
+// mostRecent is set the most recently created is returned, otherwise the
+// error lists the candidates. notFound and multiple are the error messages
+// of the calling data source.
+func Select[T any](
+	m Matcher,
+	matches []T,
+	describe func(T) Candidate,
+	mostRecent bool,
+	notFound string,
+	multiple string,
+) (*T, error) {
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("%s with %s", notFound, m)
+	case len(matches) == 1:
+		return &matches[0], nil
+	}
+
+	candidates := make([]Candidate, 0, len(matches))
+	for _, match := range matches {
+		candidates = append(candidates, describe(match))
+	}
+
+	if mostRecent {
+		return &matches[latestIndex(candidates)], nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		names = append(names, fmt.Sprintf("%q (id %d)", c.Name, c.Id))
+	}
+	slices.Sort(names)
+
+	return nil, errors.New(
+		multiple + " for " + m.String() + ": " + strings.Join(names, ", ") +
+			". Set most_recent = true or look up by id instead",
+	)
+}
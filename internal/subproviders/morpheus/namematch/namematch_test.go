@@ -0,0 +1,132 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package namematch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/namematch"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		cfgName    types.String
+		nameRegex  types.String
+		ignoreCase types.Bool
+		exact      bool
+		matches    []string
+	}{
+		{
+			name:       "exact name",
+			cfgName:    types.StringValue("web"),
+			nameRegex:  types.StringNull(),
+			ignoreCase: types.BoolNull(),
+			exact:      true,
+			matches:    []string{"web"},
+		},
+		{
+			name:       "name ignoring case",
+			cfgName:    types.StringValue("web"),
+			nameRegex:  types.StringNull(),
+			ignoreCase: types.BoolValue(true),
+			matches:    []string{"web", "WEB"},
+		},
+		{
+			name:       "name regex",
+			cfgName:    types.StringNull(),
+			nameRegex:  types.StringValue("^web"),
+			ignoreCase: types.BoolNull(),
+			matches:    []string{"web", "web-2"},
+		},
+		{
+			name:       "name regex ignoring case",
+			cfgName:    types.StringNull(),
+			nameRegex:  types.StringValue("^web"),
+			ignoreCase: types.BoolValue(true),
+			matches:    []string{"web", "WEB", "web-2"},
+		},
+		{
+			name:       "nothing set",
+			cfgName:    types.StringNull(),
+			nameRegex:  types.StringNull(),
+			ignoreCase: types.BoolNull(),
+		},
+	}
+
+	names := []string{"web", "WEB", "web-2", "db"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := namematch.New(tc.cfgName, tc.nameRegex, tc.ignoreCase)
+			assert.NoError(t, err)
+
+			_, exact := m.ExactName()
+			assert.Equal(t, tc.exact, exact)
+			assert.Equal(t, len(tc.matches) > 0, m.IsSet())
+
+			var matches []string
+			for _, n := range names {
+				if m.Match(n) {
+					matches = append(matches, n)
+				}
+			}
+			assert.Equal(t, tc.matches, matches)
+		})
+	}
+}
+
+func TestInvalidRegex(t *testing.T) {
+	t.Parallel()
+
+	_, err := namematch.New(types.StringNull(), types.StringValue("("), types.BoolNull())
+	assert.ErrorContains(t, err, "invalid name_regex")
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	candidates := []namematch.Candidate{
+		{Id: 3, Name: "web-a", DateCreated: &older},
+		{Id: 1, Name: "web-b", DateCreated: &newer},
+		{Id: 2, Name: "web-c"},
+	}
+	describe := func(c namematch.Candidate) namematch.Candidate { return c }
+
+	m, err := namematch.New(types.StringNull(), types.StringValue("^web"), types.BoolNull())
+	assert.NoError(t, err)
+
+	_, err = namematch.Select(m, nil, describe, false, "no thing found", "multiple things")
+	assert.EqualError(t, err, `no thing found with name_regex "^web"`)
+
+	c, err := namematch.Select(m, candidates[:1], describe, false, "none", "multiple")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), c.Id)
+
+	_, err = namematch.Select(m, candidates, describe, false, "none", "multiple things")
+	assert.EqualError(t, err,
+		`multiple things for name_regex "^web": "web-a" (id 3), "web-b" (id 1), `+
+			`"web-c" (id 2). Set most_recent = true or look up by id instead`,
+	)
+
+	// creation dates decide when every candidate has one
+	c, err = namematch.Select(m, candidates[:2], describe, true, "none", "multiple")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), c.Id)
+
+	// otherwise the ids do
+	c, err = namematch.Select(m, candidates, describe, true, "none", "multiple")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), c.Id)
+}
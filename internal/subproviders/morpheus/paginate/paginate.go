@@ -0,0 +1,105 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package paginate walks the offset/max paged list endpoints of the
+// Morpheus API
+package paginate
+
+import (
+	"context"
+	"iter"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+)
+
+// FetchFunc fetches the page of at most pageSize results starting at offset,
+// returning the list meta alongside when the API provides it
+type FetchFunc[T any] func(
+	ctx context.Context,
+	pageSize int64,
+	offset int64,
+) ([]T, *sdk.ListActivity200ResponseAllOfMeta, error)
+
+// Seq returns an iterator over every result of a paged list endpoint. Pages
+// are only fetched as the iteration reaches them, so breaking out early
+// avoids the remaining requests. The iteration stops after yielding the
+// first error, which is ctx.Err() if the context is cancelled between pages.
+func Seq[T any](ctx context.Context, fetch FetchFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for offset := int64(0); ; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			items, meta, err := fetch(ctx, constants.ListPageSize, offset)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if LastPage(len(items), meta) {
+				return
+			}
+			offset += int64(len(items))
+		}
+	}
+}
+
+// All returns every result of a paged list endpoint
+func All[T any](ctx context.Context, fetch FetchFunc[T]) ([]T, error) {
+	var all []T
+	for item, err := range Seq(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+
+	return all, nil
+}
+
+// Filter returns every result of a paged list endpoint for which keep
+// returns true
+func Filter[T any](
+	ctx context.Context,
+	fetch FetchFunc[T],
+	keep func(T) bool,
+) ([]T, error) {
+	var kept []T
+	for item, err := range Seq(ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept, nil
+}
+
+// LastPage reports whether a page of n results is the final one, using the
+// list meta when the API provides it
+func LastPage(n int, meta *sdk.ListActivity200ResponseAllOfMeta) bool {
+	if n < constants.ListPageSize {
+		return true
+	}
+
+	if meta == nil || meta.Total == nil {
+		return false
+	}
+
+	return meta.GetOffset()+int64(n) >= meta.GetTotal()
+}
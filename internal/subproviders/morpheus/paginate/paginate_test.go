@@ -0,0 +1,219 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package paginate_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func meta(offset, total int64) *sdk.ListActivity200ResponseAllOfMeta {
+	return &sdk.ListActivity200ResponseAllOfMeta{
+		Offset: &offset,
+		Total:  &total,
+	}
+}
+
+func TestLastPage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		n        int
+		meta     *sdk.ListActivity200ResponseAllOfMeta
+		expected bool
+	}{
+		{
+			name:     "short page without meta",
+			n:        constants.ListPageSize - 1,
+			expected: true,
+		},
+		{
+			name:     "full page without meta",
+			n:        constants.ListPageSize,
+			expected: false,
+		},
+		{
+			name:     "full page with more remaining",
+			n:        constants.ListPageSize,
+			meta:     meta(0, constants.ListPageSize+1),
+			expected: false,
+		},
+		{
+			name:     "full page reaching total",
+			n:        constants.ListPageSize,
+			meta:     meta(constants.ListPageSize, 2*constants.ListPageSize),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, paginate.LastPage(tc.n, tc.meta))
+		})
+	}
+}
+
+// pages returns a fetch function serving total items and recording the
+// offsets requested
+func pages(total int64, offsets *[]int64) paginate.FetchFunc[int64] {
+	return func(
+		_ context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]int64, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		*offsets = append(*offsets, offset)
+
+		var items []int64
+		for i := offset; i < total && i < offset+pageSize; i++ {
+			items = append(items, i)
+		}
+
+		return items, meta(offset, total), nil
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	var offsets []int64
+	total := int64(2*constants.ListPageSize + 1)
+
+	items, err := paginate.All(context.Background(), pages(total, &offsets))
+	assert.NoError(t, err)
+	assert.Len(t, items, int(total))
+	assert.Equal(t, total-1, items[len(items)-1])
+	assert.Equal(
+		t,
+		[]int64{0, constants.ListPageSize, 2 * constants.ListPageSize},
+		offsets,
+	)
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	var offsets []int64
+	total := int64(constants.ListPageSize + 10)
+
+	items, err := paginate.Filter(
+		context.Background(),
+		pages(total, &offsets),
+		func(i int64) bool { return i%50 == 0 },
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 50, 100}, items)
+}
+
+func TestSeqBreak(t *testing.T) {
+	t.Parallel()
+
+	var offsets []int64
+	for i, err := range paginate.Seq(context.Background(), pages(1000, &offsets)) {
+		assert.NoError(t, err)
+		if i == 1 {
+			break
+		}
+	}
+
+	// no further pages are fetched after breaking out
+	assert.Equal(t, []int64{0}, offsets)
+}
+
+func TestSeqError(t *testing.T) {
+	t.Parallel()
+
+	fetchErr := errors.New("boom")
+	fetch := func(
+		_ context.Context,
+		_ int64,
+		_ int64,
+	) ([]int64, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		return nil, nil, fetchErr
+	}
+
+	_, err := paginate.All(context.Background(), fetch)
+	assert.ErrorIs(t, err, fetchErr)
+}
+
+func TestSeqCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var offsets []int64
+	fetch := pages(1000, &offsets)
+	cancelling := func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]int64, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		cancel()
+
+		return fetch(ctx, pageSize, offset)
+	}
+
+	_, err := paginate.All(ctx, cancelling)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int64{0}, offsets)
+}
+
+func TestGetPage(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if r.URL.Path != "/api/networks" || q.Get("name") != "net" {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w,
+				`{"networks": [{"id": 1, "name": "net"}], `+
+					`"meta": {"max": %s, "offset": %s, "size": 1, "total": 6}}`,
+				q.Get("max"), q.Get("offset"),
+			)
+		})
+
+	ns, hresp, err := paginate.GetPage[sdk.ListNetworks200Response](
+		context.Background(),
+		client,
+		"NetworksAPIService.ListNetworks",
+		"/api/networks",
+		url.Values{"name": []string{"net"}},
+		5,
+		5,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, hresp.StatusCode)
+	assert.Equal(t, int64(1), ns.GetNetworks()[0].GetId())
+	assert.Equal(t, int64(5), ns.Meta.GetOffset())
+	assert.Equal(t, int64(5), ns.Meta.GetMax())
+
+	_, hresp, err = paginate.GetPage[sdk.ListNetworks200Response](
+		context.Background(),
+		client,
+		"NetworksAPIService.ListNetworks",
+		"/api/networks",
+		url.Values{"name": []string{"other"}},
+		5,
+		0,
+	)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, hresp.StatusCode)
+}
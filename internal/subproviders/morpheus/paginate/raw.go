@@ -0,0 +1,89 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package paginate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+)
+
+// GetPage fetches a page from a list endpoint whose SDK request builder
+// does not expose max and offset (e.g. NetworksAPI.ListNetworks), decoding
+// the body into the SDK response type R. operation is the SDK operation
+// name, used to resolve the server URL in the same way as the SDK.
+//
+// As with the SDK, the response is returned on error with its body intact so
+// that it can be passed to errors.ErrMsg.
+func GetPage[R any](
+	ctx context.Context,
+	client *sdk.APIClient,
+	operation string,
+	path string,
+	query url.Values,
+	pageSize int64,
+	offset int64,
+) (*R, *http.Response, error) {
+	cfg := client.GetConfig()
+
+	base, err := cfg.ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("max", strconv.FormatInt(pageSize, 10))
+	q.Set("offset", strconv.FormatInt(offset, 10))
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, base+path+"?"+q.Encode(), nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	for k, v := range cfg.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	hresp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, hresp, err
+	}
+
+	body, err := io.ReadAll(hresp.Body)
+	hresp.Body.Close()
+	hresp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return nil, hresp, err
+	}
+
+	if hresp.StatusCode >= http.StatusMultipleChoices {
+		// matches the error text of the SDK
+		return nil, hresp, errors.New(hresp.Status)
+	}
+
+	var r R
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, hresp, err
+	}
+
+	return &r, hresp, nil
+}
@@ -0,0 +1,70 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package permissions reads the group access of network resources, the
+// resource_permissions attribute, from the api
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+)
+
+// resourcePermission is the resource permission returned by the api
+type resourcePermission struct {
+	All   *bool `json:"all"`
+	Sites []struct {
+		Id *int64 `json:"id"`
+	} `json:"sites"`
+}
+
+// NewValueFunc is the generated NewResourcePermissionsValue of a resource
+type NewValueFunc[V any] func(
+	map[string]attr.Type,
+	map[string]attr.Value,
+) (V, diag.Diagnostics)
+
+// Value converts the resource permission returned by the api to the
+// resource_permissions attribute of a resource. The permission is any sdk
+// resource permission model or a map decoded from a response, as the sdk
+// models it differently, or not at all, for each endpoint.
+func Value[V any](
+	ctx context.Context,
+	apiPermission any,
+	attributeTypes map[string]attr.Type,
+	newValue NewValueFunc[V],
+) (V, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var p resourcePermission
+
+	b, err := json.Marshal(apiPermission)
+	if err == nil {
+		err = json.Unmarshal(b, &p)
+	}
+	if err != nil {
+		var v V
+		diags.AddError(
+			"convert resource permissions",
+			"failed to decode resource permissions: "+err.Error(),
+		)
+
+		return v, diags
+	}
+
+	var groupIds []int64
+	for _, site := range p.Sites {
+		if site.Id != nil {
+			groupIds = append(groupIds, *site.Id)
+		}
+	}
+
+	return newValue(attributeTypes, map[string]attr.Value{
+		"all":       types.BoolValue(p.All != nil && *p.All),
+		"group_ids": convert.Int64SliceToSet(groupIds),
+	})
+}
@@ -0,0 +1,83 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package permissions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/permissions"
+)
+
+var attributeTypes = map[string]attr.Type{
+	"all":       types.BoolType,
+	"group_ids": types.SetType{ElemType: types.Int64Type},
+}
+
+func newValue(
+	attributeTypes map[string]attr.Type,
+	attributes map[string]attr.Value,
+) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(attributeTypes, attributes)
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	all := true
+	groupId := int64(4)
+
+	testCases := map[string]struct {
+		permission any
+		all        bool
+		groupIds   []attr.Value
+	}{
+		"sdk model": {
+			permission: sdk.ListNetworks200ResponseAllOfNetworksInnerResourcePermission{
+				All: &all,
+				Sites: []sdk.ListNetworks200ResponseAllOfNetworksInnerResourcePermissionSitesInner{
+					{Id: &groupId},
+				},
+			},
+			all:      true,
+			groupIds: []attr.Value{types.Int64Value(4)},
+		},
+		"sdk model with sites as maps": {
+			permission: sdk.GetNetworkSubnets200ResponseAllOfSubnetsInnerResourcePermission{
+				Sites: []map[string]any{{"id": float64(4)}, {"id": float64(5)}},
+			},
+			groupIds: []attr.Value{types.Int64Value(4), types.Int64Value(5)},
+		},
+		"decoded map": {
+			permission: map[string]any{"all": true, "sites": []any{}},
+			all:        true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, diags := permissions.Value(
+				context.Background(), tc.permission, attributeTypes, newValue,
+			)
+			require.False(t, diags.HasError(), diags)
+
+			attrs := v.Attributes()
+			assert.Equal(t, types.BoolValue(tc.all), attrs["all"])
+
+			groupIds := types.SetNull(types.Int64Type)
+			if tc.groupIds != nil {
+				groupIds = types.SetValueMust(types.Int64Type, tc.groupIds)
+			}
+			assert.Equal(t, groupIds, attrs["group_ids"])
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package poll
+
+import (
+	"context"
+	"time"
+)
+
+// Until calls check every interval until it reports done, returns an
+// error, or ctx is cancelled. check is called once immediately.
+func Until(
+	ctx context.Context,
+	interval time.Duration,
+	check func(context.Context) (bool, error),
+) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package poll_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/poll"
+)
+
+func TestUntilDone(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	err := poll.Until(context.Background(), time.Millisecond,
+		func(context.Context) (bool, error) {
+			calls++
+
+			return calls == 3, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestUntilError(t *testing.T) {
+	t.Parallel()
+
+	expected := errors.New("check failed")
+
+	err := poll.Until(context.Background(), time.Millisecond,
+		func(context.Context) (bool, error) {
+			return false, expected
+		},
+	)
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %v, got %v", expected, err)
+	}
+}
+
+func TestUntilCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := poll.Until(ctx, time.Millisecond,
+		func(context.Context) (bool, error) {
+			return false, nil
+		},
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,130 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package catalogitem
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetCatalogItemAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"catalogItemType": {
+		"id": 5,
+		"name": "test",
+		"code": null,
+		"category": "servers",
+		"description": "",
+		"labels": ["a"],
+		"type": "workflow",
+		"enabled": true,
+		"featured": true,
+		"allowQuantity": false,
+		"imagePath": "/storage/logos/test.png",
+		"visibility": "public",
+		"blueprint": null,
+		"config": {"type": "ubuntu"},
+		"workflow": {"id": 8, "name": "deploy"},
+		"context": "instance",
+		"workflowConfig": "{\"size\": \"small\"}",
+		"optionTypes": [{"id": 2, "name": "size"}, {"id": 3, "name": "zone"}]
+	}}`
+
+	client := testclient.NewJSON(t, body)
+
+	state, found, diags := getCatalogItemAsState(context.Background(), 5, client)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, int64(5), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "servers", state.Category.ValueString())
+	assert.Equal(t, "workflow", state.Type.ValueString())
+	assert.True(t, state.Enabled.ValueBool())
+	assert.True(t, state.Featured.ValueBool())
+	assert.False(t, state.AllowQuantity.ValueBool())
+	assert.Equal(t, "public", state.Visibility.ValueString())
+	assert.Equal(t, int64(8), state.WorkflowId.ValueInt64())
+	assert.Equal(t, "instance", state.WorkflowContext.ValueString())
+	assert.True(t, state.BlueprintId.IsNull())
+	// unset strings are returned as null or empty strings
+	assert.True(t, state.Code.IsNull())
+	assert.True(t, state.Description.IsNull())
+	// the config and logos are carried over by the caller
+	assert.True(t, state.Config.IsNull())
+	assert.True(t, state.LogoPath.IsNull())
+
+	var optionTypeIds []int64
+	require.False(t, state.OptionTypeIds.ElementsAs(context.Background(), &optionTypeIds, false).HasError())
+	assert.ElementsMatch(t, []int64{2, 3}, optionTypeIds)
+}
+
+func TestGetCatalogItemAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getCatalogItemAsState(
+		context.Background(), 5, client,
+	)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
+
+func TestCatalogItemProperties(t *testing.T) {
+	t.Parallel()
+
+	plan := CatalogItemModel{
+		Name:          types.StringValue("test"),
+		Code:          types.StringUnknown(),
+		Category:      types.StringNull(),
+		Description:   types.StringNull(),
+		Labels:        types.SetNull(types.StringType),
+		Type:          types.StringValue("instance"),
+		Visibility:    types.StringValue("private"),
+		Enabled:       types.BoolValue(true),
+		Featured:      types.BoolValue(true),
+		AllowQuantity: types.BoolValue(false),
+		OptionTypeIds: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2)}),
+		Config:        types.StringValue(`{"type": "ubuntu", "config": {"size": "small"}}`),
+	}
+
+	item, err := catalogItemProperties(plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", item["name"])
+	assert.Equal(t, true, item["featured"])
+	assert.Equal(t, []int64{2}, item["optionTypes"])
+	// unset values are sent empty to clear them on update
+	assert.Equal(t, "", item["description"])
+	assert.Equal(t, []string{}, item["labels"])
+	assert.NotContains(t, item, "code")
+	assert.Equal(t, map[string]any{
+		"type":   "ubuntu",
+		"config": map[string]any{"size": "small"},
+	}, item["config"])
+
+	plan.Type = types.StringValue("workflow")
+	plan.WorkflowId = types.Int64Value(8)
+	plan.WorkflowContext = types.StringValue("server")
+	plan.Config = types.StringValue(`{"size": "small"}`)
+
+	item, err = catalogItemProperties(plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"id": int64(8)}, item["workflow"])
+	assert.Equal(t, "server", item["context"])
+	assert.Equal(t, `{"size": "small"}`, item["workflowConfig"])
+	assert.NotContains(t, item, "config")
+}
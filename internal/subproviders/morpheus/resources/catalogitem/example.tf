@@ -0,0 +1,38 @@
+resource "hpe_morpheus_option_list" "example" {
+  name = "Example catalog item sizes"
+  type = "manual"
+
+  initial_dataset = jsonencode([
+    { name = "Small", value = "small" },
+    { name = "Large", value = "large" },
+  ])
+}
+
+resource "hpe_morpheus_option_type" "example" {
+  name           = "Example catalog item size"
+  type           = "select"
+  field_name     = "size"
+  field_label    = "Size"
+  default_value  = "small"
+  option_list_id = hpe_morpheus_option_list.example.id
+}
+
+resource "hpe_morpheus_catalog_item" "example" {
+  name        = "Example catalog item"
+  description = "Self-service Ubuntu server"
+  category    = "servers"
+  type        = "instance"
+  featured    = true
+  visibility  = "public"
+  labels      = ["self-service"]
+
+  option_type_ids = [hpe_morpheus_option_type.example.id]
+
+  config = jsonencode({
+    name = "ubuntu-$${sequence}"
+    type = "ubuntu"
+    config = {
+      size = "<%= customOptions.size %>"
+    }
+  })
+}
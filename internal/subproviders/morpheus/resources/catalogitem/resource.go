@@ -0,0 +1,577 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package catalogitem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithIdentity       = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+const (
+	typeInstance  = "instance"
+	typeBlueprint = "blueprint"
+	typeWorkflow  = "workflow"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_catalog_item"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = CatalogItemResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// ValidateConfig checks the attribute each catalog item type orders is set,
+// as schema validators can't depend on the value of another attribute.
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config CatalogItemModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var missing bool
+	var attribute string
+
+	switch config.Type.ValueString() {
+	case typeInstance:
+		missing, attribute = config.Config.IsNull(), "config"
+	case typeBlueprint:
+		missing, attribute = config.BlueprintId.IsNull(), "blueprint_id"
+	case typeWorkflow:
+		missing, attribute = config.WorkflowId.IsNull(), "workflow_id"
+	default:
+		return
+	}
+
+	if missing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Missing attribute in configuration",
+			fmt.Sprintf("%s is required for type %q.",
+				attribute, config.Type.ValueString()),
+		)
+	}
+}
+
+// populate catalog item resource model with current API values. The config
+// is not populated, as the API fills in type specific defaults, and the logo
+// paths are local files, so callers carry them over from the plan or prior
+// state. The returned bool is false if the catalog item no longer exists.
+func getCatalogItemAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (CatalogItemModel, bool, diag.Diagnostics) {
+	var state CatalogItemModel
+	var diags diag.Diagnostics
+
+	c, hresp, err := client.CatalogItemsAPI.GetCatalogItemType(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate catalog item resource",
+			fmt.Sprintf("catalog item %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	item := c.GetCatalogItemType()
+
+	state.Id = convert.Int64ToType(item.Id)
+	state.Name = convert.StrToType(item.Name)
+	state.Code = convert.StrToTypeEmptyNull(item.Code.Get())
+	state.Category = convert.StrToTypeEmptyNull(item.Category.Get())
+	state.Description = convert.StrToTypeEmptyNull(item.Description.Get())
+	state.Labels = convert.StrSliceToSet(item.Labels)
+	state.Type = convert.StrToType(item.Type)
+	state.Visibility = convert.StrToType(item.Visibility)
+	state.Enabled = convert.BoolToType(item.Enabled)
+	state.Featured = convert.BoolToType(item.Featured)
+	state.AllowQuantity = convert.BoolToType(item.AllowQuantity)
+	state.Config = types.StringNull()
+	state.LogoPath = types.StringNull()
+	state.DarkLogoPath = types.StringNull()
+
+	state.BlueprintId = types.Int64Null()
+	if blueprintId, ok := item.Blueprint["id"].(float64); ok {
+		state.BlueprintId = types.Int64Value(int64(blueprintId))
+	}
+
+	state.WorkflowId = types.Int64Null()
+	if item.Workflow != nil {
+		state.WorkflowId = convert.Int64ToType(item.Workflow.Id)
+	}
+
+	// the sdk doesn't model the workflow context
+	workflowContext, _ := item.AdditionalProperties["context"].(string)
+	state.WorkflowContext = convert.StrToTypeEmptyNull(&workflowContext)
+
+	var optionTypeIds []int64
+	for _, o := range item.OptionTypes {
+		if o.Id != nil {
+			optionTypeIds = append(optionTypeIds, *o.Id)
+		}
+	}
+	state.OptionTypeIds = convert.Int64SliceToSet(optionTypeIds)
+
+	return state, true, diags
+}
+
+// catalogItemProperties returns the catalog item sent to the API. The sdk
+// models the catalog item as one of the item types, each with a typed
+// config, so the catalog item is sent as an additional property. Empty
+// values clear the settings on update.
+func catalogItemProperties(plan CatalogItemModel) (map[string]any, error) {
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert labels: %w", err)
+	}
+
+	optionTypeIds, err := convert.SetToInt64Slice(plan.OptionTypeIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert option type ids: %w", err)
+	}
+
+	item := map[string]any{
+		"name":          plan.Name.ValueString(),
+		"category":      plan.Category.ValueString(),
+		"description":   plan.Description.ValueString(),
+		"labels":        append([]string{}, labels...),
+		"type":          plan.Type.ValueString(),
+		"visibility":    plan.Visibility.ValueString(),
+		"enabled":       plan.Enabled.ValueBool(),
+		"featured":      plan.Featured.ValueBool(),
+		"allowQuantity": plan.AllowQuantity.ValueBool(),
+		"optionTypes":   append([]int64{}, optionTypeIds...),
+	}
+
+	if !plan.Code.IsNull() && !plan.Code.IsUnknown() {
+		item["code"] = plan.Code.ValueString()
+	}
+
+	switch plan.Type.ValueString() {
+	case typeInstance:
+		var config map[string]any
+		if err := json.Unmarshal([]byte(plan.Config.ValueString()), &config); err != nil {
+			return nil, fmt.Errorf("failed to decode config: %w", err)
+		}
+
+		item["config"] = config
+	case typeBlueprint:
+		item["blueprint"] = map[string]any{"id": plan.BlueprintId.ValueInt64()}
+		if !plan.Config.IsNull() {
+			item["appSpec"] = plan.Config.ValueString()
+		}
+	case typeWorkflow:
+		item["workflow"] = map[string]any{"id": plan.WorkflowId.ValueInt64()}
+		item["context"] = plan.WorkflowContext.ValueString()
+		if !plan.Config.IsNull() {
+			item["workflowConfig"] = plan.Config.ValueString()
+		}
+	}
+
+	return item, nil
+}
+
+// uploadLogos uploads the logo files whose paths differ from the prior
+// state. A nil prior uploads every logo that is set.
+func uploadLogos(
+	ctx context.Context,
+	id int64,
+	plan CatalogItemModel,
+	prior *CatalogItemModel,
+	client *sdk.APIClient,
+) error {
+	changed := func(planPath, priorPath types.String) bool {
+		if planPath.IsNull() {
+			return false
+		}
+
+		return prior == nil || !planPath.Equal(priorPath)
+	}
+
+	var priorLogo, priorDarkLogo types.String
+	if prior != nil {
+		priorLogo, priorDarkLogo = prior.LogoPath, prior.DarkLogoPath
+	}
+
+	uploadLogo := changed(plan.LogoPath, priorLogo)
+	uploadDarkLogo := changed(plan.DarkLogoPath, priorDarkLogo)
+	if !uploadLogo && !uploadDarkLogo {
+		return nil
+	}
+
+	req := client.CatalogItemsAPI.UpdateCatalogItemTypeLogo(ctx, id)
+
+	if uploadLogo {
+		f, err := os.Open(plan.LogoPath.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to open logo: %w", err)
+		}
+		defer f.Close()
+
+		req = req.CatalogItemTypeLogo(f)
+	}
+
+	if uploadDarkLogo {
+		f, err := os.Open(plan.DarkLogoPath.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to open dark logo: %w", err)
+		}
+		defer f.Close()
+
+		req = req.CatalogItemTypeDarkLogo(f)
+	}
+
+	_, hresp, err := req.Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		return fmt.Errorf("logo PUT failed: %s", errors.ErrMsg(err, hresp))
+	}
+
+	return nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan CatalogItemModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	item, err := catalogItemProperties(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			"catalog item "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			"catalog item "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addCatalogItem := sdk.NewAddCatalogItemTypeRequest()
+	addCatalogItem.AdditionalProperties = map[string]any{"catalogItemType": item}
+
+	c, hresp, err := client.CatalogItemsAPI.AddCatalogItemType(ctx).
+		AddCatalogItemTypeRequest(*addCatalogItem).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			"catalog item "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	created := c.CatalogItemType
+	if created == nil || created.Id == nil {
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			"catalog item "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *created.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := uploadLogos(ctx, id, plan, nil, client); err != nil {
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			fmt.Sprintf("catalog item %d: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getCatalogItemAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create catalog item resource",
+			fmt.Sprintf("catalog item %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.Config = plan.Config
+	state.LogoPath = plan.LogoPath
+	state.DarkLogoPath = plan.DarkLogoPath
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, prior CatalogItemModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	item, err := catalogItemProperties(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update catalog item resource",
+			fmt.Sprintf("catalog item %d: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update catalog item resource",
+			fmt.Sprintf("catalog item %d: failed to create client: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	updateCatalogItem := sdk.NewUpdateCatalogItemTypeRequest()
+	updateCatalogItem.AdditionalProperties = map[string]any{"catalogItemType": item}
+
+	_, hresp, err := client.CatalogItemsAPI.UpdateCatalogItemType(ctx, id).
+		UpdateCatalogItemTypeRequest(*updateCatalogItem).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update catalog item resource",
+			fmt.Sprintf("catalog item %d PUT failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if err := uploadLogos(ctx, id, plan, &prior, client); err != nil {
+		resp.Diagnostics.AddError(
+			"update catalog item resource",
+			fmt.Sprintf("catalog item %d: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getCatalogItemAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update catalog item resource",
+			fmt.Sprintf("catalog item %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.Config = plan.Config
+	state.LogoPath = plan.LogoPath
+	state.DarkLogoPath = plan.DarkLogoPath
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data CatalogItemModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read catalog item resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getCatalogItemAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read catalog item resource",
+			fmt.Sprintf("catalog item %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the catalog item was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.Config = data.Config
+	state.LogoPath = data.LogoPath
+	state.DarkLogoPath = data.DarkLogoPath
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data CatalogItemModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete catalog item resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.CatalogItemsAPI.RemoveCatalogItemType(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete catalog item resource",
+			fmt.Sprintf("catalog item %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import catalog item resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,214 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example catalog item"
+
+package catalogitem_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+// a 1x1 transparent png
+const logoPng = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusCatalogItemExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_catalog_item.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_catalog_item.example",
+			"type",
+			"instance",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_catalog_item.example",
+			"featured",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_catalog_item.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_catalog_item.example",
+			"option_type_ids.#",
+			"1",
+		),
+		resource.TestCheckTypeSetElemAttr(
+			"hpe_morpheus_catalog_item.example",
+			"labels.*",
+			"self-service",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				// the config isn't read back from the api
+				ImportStateVerifyIgnore: []string{"config"},
+				ResourceName:            "hpe_morpheus_catalog_item.example",
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusCatalogItemUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	png, err := base64.StdEncoding.DecodeString(logoPng)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logoPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logoPath, png, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_catalog_item" "test" {
+  name   = "` + name + `"
+  type   = "instance"
+  config = jsonencode({ type = "ubuntu" })
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "featured", "false"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "visibility", "private"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the catalog item is updated in place and the logo is uploaded
+resource "hpe_morpheus_catalog_item" "test" {
+  name           = "` + name + `-renamed"
+  description    = "Ubuntu server"
+  type           = "instance"
+  featured       = true
+  allow_quantity = true
+  logo_path      = "` + filepath.ToSlash(logoPath) + `"
+  config         = jsonencode({ type = "ubuntu", name = "ubuntu" })
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_catalog_item.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "description", "Ubuntu server"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "featured", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "allow_quantity", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_catalog_item.test", "logo_path", filepath.ToSlash(logoPath)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusCatalogItemMissingWorkflow(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_catalog_item" "test" {
+  name = "missing-workflow"
+  type = "workflow"
+}`,
+				ExpectError: regexp.MustCompile(`workflow_id is required for type "workflow"`),
+			},
+		},
+	})
+}
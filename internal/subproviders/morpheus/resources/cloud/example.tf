@@ -0,0 +1,14 @@
+resource "hpe_morpheus_cloud" "example" {
+  name            = "Example cloud"
+  description     = "Generic cloud for manually managed hosts"
+  type_code       = "standard"
+  group_id        = 1
+  inventory_level = "basic"
+  time_zone       = "Europe/London"
+  labels          = ["example"]
+  visibility      = "private"
+
+  credential_username            = "svc-morpheus"
+  credential_password_wo         = "Secret123!"
+  credential_password_wo_version = 1
+}
@@ -0,0 +1,581 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/poll"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// cloud statuses reported once the initial sync has finished
+const (
+	statusOk      = "ok"
+	statusWarning = "warning"
+	statusError   = "error"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_cloud"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = CloudResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// groupIdFromGroups keeps the prior group id while the cloud is still
+// attached to that group, otherwise (e.g. on import) the first group
+// returned by the API is used
+func groupIdFromGroups(
+	prior types.Int64,
+	groups []sdk.ListClouds200ResponseAllOfZonesInnerGroupsInner,
+) types.Int64 {
+	for _, g := range groups {
+		if g.Id != nil && !prior.IsNull() && *g.Id == prior.ValueInt64() {
+			return prior
+		}
+	}
+
+	if len(groups) == 0 {
+		return types.Int64Null()
+	}
+
+	return convert.Int64ToType(groups[0].Id)
+}
+
+// populate cloud resource model with current API values. The config and
+// credentials are not populated, as the API masks secrets and reports
+// type specific defaults, so callers carry them over from the plan or
+// prior state. The returned bool is false if the cloud no longer exists.
+func getCloudAsState(
+	ctx context.Context,
+	id int64,
+	prior CloudModel,
+	client *sdk.APIClient,
+) (CloudModel, bool, diag.Diagnostics) {
+	var state CloudModel
+	var diags diag.Diagnostics
+
+	c, hresp, err := client.CloudsAPI.GetClouds(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate cloud resource",
+			fmt.Sprintf("cloud %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	cloud := c.GetZone()
+
+	state.Id = convert.Int64ToType(cloud.Id)
+	state.Name = convert.StrToType(cloud.Name)
+	state.Code = convert.StrToType(cloud.Code)
+	state.TypeCode = convert.StrToType(cloud.GetZoneType().Code)
+	state.Enabled = convert.BoolToType(cloud.Enabled)
+	state.Visibility = convert.StrToType(cloud.Visibility)
+	state.Status = convert.StrToType(cloud.Status)
+	state.InventoryLevel = convert.StrToType(cloud.InventoryLevel)
+	state.Labels = convert.StrSliceToSet(cloud.Labels)
+	state.Location = convert.StrToTypeEmptyNull(cloud.Location.Get())
+	state.TimeZone = convert.StrToTypeEmptyNull(cloud.Timezone.Get())
+
+	// the description isn't modelled by the sdk response type
+	description, _ := cloud.AdditionalProperties["description"].(string)
+	state.Description = convert.StrToTypeEmptyNull(&description)
+
+	var groupIds []int64
+	for _, g := range cloud.Groups {
+		if g.Id != nil {
+			groupIds = append(groupIds, *g.Id)
+		}
+	}
+	state.GroupIds = convert.Int64SliceToSet(groupIds)
+	state.GroupId = groupIdFromGroups(prior.GroupId, cloud.Groups)
+
+	state.Config = prior.Config
+	state.CredentialUsername = prior.CredentialUsername
+	state.CredentialPasswordWoVersion = prior.CredentialPasswordWoVersion
+
+	return state, true, diags
+}
+
+// newCloudConfig converts the dynamic config attribute to the map sent to
+// the API and adds the credentials to it. The password is only sent when
+// given, so that an update doesn't clear the stored password.
+func newCloudConfig(
+	ctx context.Context,
+	plan CloudModel,
+	password *string,
+) (map[string]any, error) {
+	config := map[string]any{}
+
+	if !plan.Config.IsNull() && !plan.Config.IsUnknown() {
+		configAny, err := convert.ValueToAny(ctx, plan.Config.UnderlyingValue())
+		if err != nil {
+			return nil, err
+		}
+
+		configMap, ok := configAny.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("config must be a valid object/map")
+		}
+
+		config = configMap
+	}
+
+	if !plan.CredentialUsername.IsNull() {
+		config["username"] = plan.CredentialUsername.ValueString()
+	}
+
+	if password != nil {
+		config["password"] = *password
+	}
+
+	return config, nil
+}
+
+// cloudProperties returns the settings the sdk request types don't model
+func cloudProperties(plan CloudModel) map[string]any {
+	properties := map[string]any{}
+
+	if !plan.InventoryLevel.IsNull() && !plan.InventoryLevel.IsUnknown() {
+		properties["inventoryLevel"] = plan.InventoryLevel.ValueString()
+	}
+
+	if !plan.TimeZone.IsNull() && !plan.TimeZone.IsUnknown() {
+		properties["timezone"] = plan.TimeZone.ValueString()
+	}
+
+	return properties
+}
+
+// waitForSync polls the cloud until its initial sync has finished
+func waitForSync(ctx context.Context, id int64, client *sdk.APIClient) error {
+	waitCtx, cancel := context.WithTimeout(ctx, constants.CloudSyncTimeout)
+	defer cancel()
+
+	return poll.Until(waitCtx, constants.PollInterval,
+		func(ctx context.Context) (bool, error) {
+			c, hresp, err := client.CloudsAPI.GetClouds(ctx, id).Execute()
+			if err != nil || c == nil {
+				return false, fmt.Errorf(
+					"cloud %d GET failed: %s", id, errors.ErrMsg(err, hresp),
+				)
+			}
+
+			cloud := c.GetZone()
+			status := cloud.GetStatus()
+
+			if status == statusError {
+				return false, fmt.Errorf(
+					"cloud %d entered status %s: %s",
+					id, status, cloud.GetStatusMessage(),
+				)
+			}
+
+			return status == statusOk || status == statusWarning, nil
+		},
+	)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan, config CloudModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	var password *string
+	if !config.CredentialPasswordWo.IsNull() {
+		password = config.CredentialPasswordWo.ValueStringPointer()
+	}
+
+	cloudConfig, err := newCloudConfig(ctx, plan, password)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			"cloud "+name+": failed to convert config: "+err.Error(),
+		)
+
+		return
+	}
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			"cloud "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	zoneType := sdk.AddCloudsRequestZoneZoneType{
+		AddCloudsRequestZoneZoneTypeAnyOf1: &sdk.AddCloudsRequestZoneZoneTypeAnyOf1{
+			Code: plan.TypeCode.ValueStringPointer(),
+		},
+	}
+
+	cloud := sdk.NewAddCloudsRequestZone(
+		name,
+		plan.GroupId.ValueInt64(),
+		zoneType,
+		sdk.AddCloudsRequestZoneConfig{MapmapOfStringAny: &cloudConfig},
+	)
+	cloud.SetVisibility(plan.Visibility.ValueString())
+	cloud.SetEnabled(plan.Enabled.ValueBool())
+	cloud.SetLabels(labels)
+	if !plan.Description.IsNull() {
+		cloud.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.Code.IsNull() && !plan.Code.IsUnknown() {
+		cloud.SetCode(plan.Code.ValueString())
+	}
+	if !plan.Location.IsNull() {
+		cloud.SetLocation(plan.Location.ValueString())
+	}
+	cloud.AdditionalProperties = cloudProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			"cloud "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	c, hresp, err := client.CloudsAPI.AddClouds(ctx).
+		AddCloudsRequest(*sdk.NewAddCloudsRequest(*cloud)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			"cloud "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if c.GetZone().Id == nil {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			"cloud "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *c.GetZone().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the state is still refreshed when the sync fails, so that the
+	// tainted cloud has no unknown values
+	if err := waitForSync(ctx, id, client); err != nil {
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			fmt.Sprintf("waiting for cloud %d to sync failed: ", id)+err.Error(),
+		)
+	}
+
+	state, found, pdiags := getCloudAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create cloud resource",
+			fmt.Sprintf("cloud %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state, config CloudModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	var password *string
+	if !plan.CredentialPasswordWoVersion.Equal(state.CredentialPasswordWoVersion) {
+		if config.CredentialPasswordWo.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"update cloud resource",
+				fmt.Sprintf("cloud %s: 'credential_password_wo_version' changed, "+
+					"but 'credential_password_wo' is not set", name),
+			)
+
+			return
+		}
+		// an empty password clears it
+		p := config.CredentialPasswordWo.ValueString()
+		password = &p
+	}
+
+	cloudConfig, err := newCloudConfig(ctx, plan, password)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update cloud resource",
+			"cloud "+name+": failed to convert config: "+err.Error(),
+		)
+
+		return
+	}
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update cloud resource",
+			"cloud "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	// credentials are stored locally in the config
+	credential := map[string]any{"type": "local"}
+
+	cloud := sdk.NewUpdateCloudsRequestZone(
+		name,
+		plan.TypeCode.ValueString(),
+		plan.GroupId.ValueInt64(),
+		credential,
+	)
+	cloud.SetConfig(cloudConfig)
+	cloud.SetVisibility(plan.Visibility.ValueString())
+	cloud.SetEnabled(plan.Enabled.ValueBool())
+	// an empty list clears the labels
+	cloud.Labels = append([]string{}, labels...)
+	// empty strings clear unset attributes
+	cloud.SetDescription(plan.Description.ValueString())
+	cloud.SetLocation(plan.Location.ValueString())
+	if !plan.Code.IsUnknown() {
+		cloud.SetCode(plan.Code.ValueString())
+	}
+	cloud.AdditionalProperties = cloudProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update cloud resource",
+			"cloud "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.CloudsAPI.UpdateClouds(ctx, id).
+		UpdateCloudsRequest(*sdk.NewUpdateCloudsRequest(*cloud)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update cloud resource",
+			"cloud "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	newState, found, pdiags := getCloudAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update cloud resource",
+			fmt.Sprintf("cloud %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: newState.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data CloudModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read cloud resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getCloudAsState(ctx, id, data, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read cloud resource",
+			fmt.Sprintf("cloud %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the cloud was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data CloudModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete cloud resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.CloudsAPI.RemoveClouds(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete cloud resource",
+			fmt.Sprintf("cloud %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import cloud resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,207 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example cloud" Description "Generic cloud for manually managed hosts" TypeCode "standard" GroupId 1 InventoryLevel "basic" TimeZone "Europe/London"
+
+package cloud_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusCloudExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "test cloud",
+		"TypeCode", "standard",
+		"GroupId", "1",
+		"InventoryLevel", "basic",
+		"TimeZone", "Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"description",
+			"test cloud",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"type_code",
+			"standard",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"group_id",
+			"1",
+		),
+		resource.TestCheckTypeSetElemAttr(
+			"hpe_morpheus_cloud.example",
+			"group_ids.*",
+			"1",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"inventory_level",
+			"basic",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"time_zone",
+			"Europe/London",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"labels.#",
+			"1",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"enabled",
+			"true",
+		),
+		resource.TestCheckResourceAttrSet(
+			"hpe_morpheus_cloud.example",
+			"status",
+		),
+		resource.TestCheckNoResourceAttr(
+			"hpe_morpheus_cloud.example",
+			"credential_password_wo",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_cloud.example",
+				// not returned by the api
+				ImportStateVerifyIgnore: []string{
+					"credential_username",
+					"credential_password_wo_version",
+				},
+				Check: checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusCloudUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_cloud" "test" {
+  name        = "` + name + `"
+  description = "before"
+  location    = "London"
+  type_code   = "standard"
+  group_id    = 1
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_cloud.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_cloud.test", "location", "London"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the cloud is updated in place and the
+# description and location are cleared
+resource "hpe_morpheus_cloud" "test" {
+  name            = "` + name + `-updated"
+  type_code       = "standard"
+  group_id        = 1
+  enabled         = false
+  inventory_level = "full"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_cloud.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_cloud.test", "name", name+"-updated"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_cloud.test", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_cloud.test", "inventory_level", "full"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_cloud.test", "description"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_cloud.test", "location"),
+				),
+			},
+		},
+	})
+}
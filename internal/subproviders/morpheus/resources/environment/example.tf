@@ -0,0 +1,7 @@
+resource "hpe_morpheus_environment" "example" {
+  name        = "Staging"
+  code        = "staging"
+  description = "Staging environment"
+  visibility  = "private"
+  sort_order  = 10
+}
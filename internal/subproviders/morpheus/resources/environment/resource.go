@@ -0,0 +1,390 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_environment"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = EnvironmentResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate environment resource model with current API values. The returned
+// bool is false if the environment no longer exists.
+func getEnvironmentAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (EnvironmentModel, bool, diag.Diagnostics) {
+	var state EnvironmentModel
+	var diags diag.Diagnostics
+
+	e, hresp, err := client.EnvironmentsAPI.GetEnvironments(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate environment resource",
+			fmt.Sprintf("environment %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	env := e.GetEnvironment()
+
+	state.Id = convert.Int64ToType(env.Id)
+	state.Name = convert.StrToType(env.Name)
+	state.Code = convert.StrToType(env.Code)
+	state.Active = convert.BoolToType(env.Active)
+	state.SortOrder = convert.Int64ToType(env.SortOrder)
+	state.Visibility = convert.StrToType(env.Visibility)
+
+	state.Description = convert.StrToTypeEmptyNull(env.Description)
+
+	return state, true, diags
+}
+
+// setActive updates the active flag, which can't be set by the POST
+func setActive(
+	ctx context.Context,
+	id int64,
+	active bool,
+	client *sdk.APIClient,
+) error {
+	updateEnv := sdk.NewUpdateEnvironmentsRequestEnvironmentWithDefaults()
+	updateEnv.SetActive(active)
+
+	updateEnvReq := sdk.NewUpdateEnvironmentsRequest(*updateEnv)
+
+	_, hresp, err := client.EnvironmentsAPI.UpdateEnvironments(ctx, id).
+		UpdateEnvironmentsRequest(*updateEnvReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		return fmt.Errorf("environment %d PUT failed: %s", id, errors.ErrMsg(err, hresp))
+	}
+
+	return nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan EnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	addEnv := sdk.NewAddEnvironmentsRequestEnvironment(name, plan.Code.ValueString())
+
+	if !plan.Description.IsNull() {
+		addEnv.SetDescription(plan.Description.ValueString())
+	}
+
+	if !plan.Visibility.IsUnknown() {
+		addEnv.SetVisibility(plan.Visibility.ValueString())
+	}
+
+	if !plan.SortOrder.IsUnknown() {
+		addEnv.SetSortOrder(plan.SortOrder.ValueInt64())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create environment resource",
+			"environment "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addEnvReq := sdk.NewAddEnvironmentsRequest(*addEnv)
+
+	env, hresp, err := client.EnvironmentsAPI.AddEnvironments(ctx).
+		AddEnvironmentsRequest(*addEnvReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create environment resource",
+			"environment "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if env.GetEnvironment().Id == nil {
+		resp.Diagnostics.AddError(
+			"create environment resource",
+			"environment "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *env.GetEnvironment().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Active.ValueBool() {
+		if err := setActive(ctx, id, false, client); err != nil {
+			resp.Diagnostics.AddError(
+				"create environment resource",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
+	state, found, pdiags := getEnvironmentAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create environment resource",
+			fmt.Sprintf("environment %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan EnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	updateEnv := sdk.NewUpdateEnvironmentsRequestEnvironmentWithDefaults()
+	updateEnv.SetName(name)
+	// an empty description clears it
+	updateEnv.SetDescription(plan.Description.ValueString())
+	updateEnv.SetVisibility(plan.Visibility.ValueString())
+	updateEnv.SetActive(plan.Active.ValueBool())
+
+	if !plan.SortOrder.IsUnknown() {
+		updateEnv.SetSortOrder(plan.SortOrder.ValueInt64())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update environment resource",
+			"environment "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateEnvReq := sdk.NewUpdateEnvironmentsRequest(*updateEnv)
+
+	_, hresp, err := client.EnvironmentsAPI.UpdateEnvironments(ctx, id).
+		UpdateEnvironmentsRequest(*updateEnvReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update environment resource",
+			"environment "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getEnvironmentAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update environment resource",
+			fmt.Sprintf("environment %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data EnvironmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read environment resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getEnvironmentAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read environment resource",
+			fmt.Sprintf("environment %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the environment was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data EnvironmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete environment resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.EnvironmentsAPI.DeleteEnvironments(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete environment resource",
+			fmt.Sprintf("environment %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import environment resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,180 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Staging" Code "staging" Description "Staging environment" Visibility "private" SortOrder 10
+
+package environment_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusEnvironmentExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+	code := strings.ToLower(name)
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Code", code,
+		"Description", "An example environment",
+		"Visibility", "public",
+		"SortOrder", "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"code",
+			code,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"description",
+			"An example environment",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"sort_order",
+			"10",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_environment.example",
+			"active",
+			"true",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_environment.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusEnvironmentUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+	code := strings.ToLower(name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_environment" "test" {
+  name        = "` + name + `"
+  code        = "` + code + `"
+  description = "before"
+  active      = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "active", "false"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "description", "before"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks name, visibility and active are updated in place
+# and the description is cleared
+resource "hpe_morpheus_environment" "test" {
+  name       = "` + name + `-changed"
+  code       = "` + code + `"
+  visibility = "public"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "name", name+"-changed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "active", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_environment.test", "visibility", "public"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_environment.test", "description"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks plan detects code change
+resource "hpe_morpheus_environment" "test" {
+  name       = "` + name + `-changed"
+  code       = "` + code + `-changed"
+  visibility = "public"
+}`,
+				ExpectNonEmptyPlan: true,
+				PlanOnly:           true,
+			},
+		},
+	})
+}
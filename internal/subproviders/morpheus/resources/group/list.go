@@ -0,0 +1,152 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/listresult"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+var (
+	_ list.ListResource              = &ListResource{}
+	_ list.ListResourceWithConfigure = &ListResource{}
+)
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+// ListResource lists existing groups for terraform query
+type ListResource struct {
+	configure.ResourceWithMorpheusConfigure
+}
+
+type ListModel struct {
+	Name   types.String `tfsdk:"name"`
+	Phrase types.String `tfsdk:"phrase"`
+	Label  types.String `tfsdk:"label"`
+}
+
+func (r *ListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_group"
+}
+
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list groups with this name",
+				MarkdownDescription: "Only list groups with this name",
+			},
+			"phrase": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list groups matching this search phrase",
+				MarkdownDescription: "Only list groups matching this search phrase",
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list groups with this label",
+				MarkdownDescription: "Only list groups with this label",
+			},
+		},
+	}
+}
+
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config ListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		diags.AddError("list group resource", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	fetch := func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListGroups200ResponseAllOfGroupsInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		listReq := client.GroupsAPI.ListGroups(ctx).Max(pageSize).Offset(offset)
+		if !config.Name.IsNull() {
+			listReq = listReq.Name(config.Name.ValueString())
+		}
+		if !config.Phrase.IsNull() {
+			listReq = listReq.Phrase(config.Phrase.ValueString())
+		}
+		if !config.Label.IsNull() {
+			listReq = listReq.Labels(config.Label.ValueString())
+		}
+
+		groups, hresp, err := listReq.Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("groups GET failed: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return groups.GetGroups(), groups.Meta, nil
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for g, err := range paginate.Seq(ctx, fetch) {
+			if err != nil {
+				push(listresult.Error("list group resource", err.Error()))
+
+				return
+			}
+
+			if g.Id == nil {
+				continue
+			}
+
+			id := *g.Id
+			result := listresult.New(ctx, req, id, g.GetName(),
+				func() (GroupModel, diag.Diagnostics) {
+					return getGroupAsState(ctx, id, client)
+				},
+			)
+			if !push(result) {
+				return
+			}
+
+			count++
+			if listresult.LimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
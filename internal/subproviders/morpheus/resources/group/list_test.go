@@ -0,0 +1,68 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package group_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/group"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testlist"
+)
+
+func TestListGroups(t *testing.T) {
+	t.Parallel()
+
+	// more than one page of groups
+	const total = 150
+
+	handler := testlist.Handler(t, "/api/groups", "groups", "group", total,
+		func(id int64) map[string]any {
+			return map[string]any{
+				"id":       id,
+				"name":     fmt.Sprintf("group-%d", id),
+				"code":     fmt.Sprintf("code-%d", id),
+				"location": "here",
+				"labels":   []string{"test"},
+			}
+		},
+	)
+
+	results := testlist.Run(t,
+		group.NewListResource().(list.ListResourceWithConfigure),
+		group.NewResource().(resource.ResourceWithIdentity),
+		true, handler,
+	)
+	require.Len(t, results, total)
+
+	ctx := context.Background()
+	for i, result := range results {
+		id := int64(i + 1)
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, fmt.Sprintf("group-%d", id), result.DisplayName)
+
+		var ident identity.Model
+		require.False(t, result.Identity.Get(ctx, &ident).HasError())
+		assert.Equal(t, types.Int64Value(id), ident.Id)
+
+		var state group.GroupModel
+		require.False(t, result.Resource.Get(ctx, &state).HasError())
+		assert.Equal(t, types.Int64Value(id), state.Id)
+		assert.Equal(t, types.StringValue(fmt.Sprintf("group-%d", id)), state.Name)
+		assert.Equal(t, types.StringValue(fmt.Sprintf("code-%d", id)), state.Code)
+		assert.Equal(t, types.StringValue("here"), state.Location)
+		assert.Equal(t, types.SetValueMust(types.StringType,
+			[]attr.Value{types.StringValue("test")}), state.Labels)
+	}
+}
@@ -17,12 +17,14 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
 func NewResource() resource.Resource {
@@ -51,6 +53,14 @@ func (r *Resource) Schema(
 	resp.Schema = GroupResourceSchema(ctx)
 }
 
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
 // populate group resource model with current API values
 func getGroupAsState(
 	ctx context.Context,
@@ -158,6 +168,9 @@ func (r *Resource) Create(
 
 	// write id as soon as possible
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -287,6 +300,9 @@ func (r *Resource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
 }
 
 func (r *Resource) Read(
@@ -324,9 +340,9 @@ func (r *Resource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
 }
 
 func (r *Resource) Delete(
@@ -362,6 +378,13 @@ func (r *Resource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
 	id, err := strconv.Atoi(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,179 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package groupcloudattachment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+// newTestClient serves a group with the given clouds and records the
+// cloud ids of any PUT to the group's zones, which later GETs return
+func newTestClient(t *testing.T, cloudIds []int64, put *[]int64) *sdk.APIClient {
+	t.Helper()
+
+	return newGroupClient(t, cloudIds, put, true)
+}
+
+// newGroupClient serves a group with the given clouds and records the cloud
+// ids of any PUT to the group's zones. Later GETs only return the PUT cloud
+// ids if apply is set, otherwise the PUT is lost as if overwritten by
+// another client.
+func newGroupClient(
+	t *testing.T,
+	cloudIds []int64,
+	put *[]int64,
+	apply bool,
+) *sdk.APIClient {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	return testclient.New(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/groups/5":
+				zones := []map[string]any{}
+				for _, id := range cloudIds {
+					zones = append(zones, map[string]any{"id": id})
+				}
+				body, _ := json.Marshal(map[string]any{
+					"group": map[string]any{"id": 5, "zones": zones},
+				})
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(body)
+			case r.Method == http.MethodPut && r.URL.Path == "/api/groups/5/update-zones":
+				var req struct {
+					Group struct {
+						Zones []struct {
+							Id int64 `json:"id"`
+						} `json:"zones"`
+					} `json:"group"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+
+					return
+				}
+
+				ids := []int64{}
+				for _, z := range req.Group.Zones {
+					ids = append(ids, z.Id)
+				}
+				*put = ids
+				if apply {
+					cloudIds = ids
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"success": true}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	)
+}
+
+func TestAttachCloud(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, []int64{1, 2}, &put)
+
+	err := attachCloud(context.Background(), 5, 3, client)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, put)
+}
+
+func TestAttachCloudAlreadyAttached(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, []int64{1, 3}, &put)
+
+	err := attachCloud(context.Background(), 5, 3, client)
+	require.NoError(t, err)
+	assert.Nil(t, put)
+}
+
+func TestAttachCloudGroupNotFound(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, nil, &put)
+
+	err := attachCloud(context.Background(), 6, 3, client)
+	require.Error(t, err)
+	assert.Nil(t, put)
+}
+
+func TestDetachCloud(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, []int64{1, 3, 2}, &put)
+
+	err := detachCloud(context.Background(), 5, 3, client)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, put)
+}
+
+func TestDetachLastCloud(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, []int64{3}, &put)
+
+	err := detachCloud(context.Background(), 5, 3, client)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{}, put)
+}
+
+func TestDetachCloudNotAttached(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newTestClient(t, []int64{1}, &put)
+
+	err := detachCloud(context.Background(), 5, 3, client)
+	require.NoError(t, err)
+	assert.Nil(t, put)
+}
+
+func TestAttachCloudOverwritten(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newGroupClient(t, []int64{1, 2}, &put, false)
+
+	err := attachCloud(context.Background(), 5, 3, client)
+	require.EqualError(t, err, "cloud 3 not in group 5 after PUT")
+	assert.Equal(t, []int64{1, 2, 3}, put)
+}
+
+func TestDetachCloudOverwritten(t *testing.T) {
+	t.Parallel()
+
+	var put []int64
+	client := newGroupClient(t, []int64{1, 3}, &put, false)
+
+	err := detachCloud(context.Background(), 5, 3, client)
+	require.EqualError(t, err, "cloud 3 still in group 5 after PUT")
+	assert.Equal(t, []int64{1}, put)
+}
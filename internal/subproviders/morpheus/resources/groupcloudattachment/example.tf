@@ -0,0 +1,8 @@
+resource "hpe_morpheus_group" "example" {
+  name = "Shared group"
+}
+
+resource "hpe_morpheus_group_cloud_attachment" "example" {
+  group_id = hpe_morpheus_group.example.id
+  cloud_id = 1
+}
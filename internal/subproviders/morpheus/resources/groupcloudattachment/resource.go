@@ -0,0 +1,412 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package groupcloudattachment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// The API only replaces the full list of clouds in a group, so attachments
+// are read, modified and written back. Serialise that so that attachments
+// to the same group applied in parallel don't overwrite each other. This
+// only covers a single provider process: a change to the group's clouds made
+// by another workspace or in the UI between the GET and the PUT is
+// overwritten. The group is read again after the PUT to confirm that the
+// change took effect.
+var groupCloudsMu sync.Mutex
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+// attachments are addressed by group and cloud id
+type identityModel struct {
+	GroupId types.Int64 `tfsdk:"group_id"`
+	CloudId types.Int64 `tfsdk:"cloud_id"`
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName +
+		"_group_cloud_attachment"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = GroupCloudAttachmentResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the group",
+			},
+			"cloud_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the cloud",
+			},
+		},
+	}
+}
+
+// getGroupCloudIds returns the ids of the clouds in a group. The returned
+// bool is false if the group no longer exists.
+func getGroupCloudIds(
+	ctx context.Context,
+	groupId int64,
+	client *sdk.APIClient,
+) ([]int64, bool, error) {
+	g, hresp, err := client.GroupsAPI.GetGroups(ctx, groupId).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		return nil, true, fmt.Errorf(
+			"group %d GET failed: %s", groupId, errors.ErrMsg(err, hresp),
+		)
+	}
+
+	var ids []int64
+	for _, z := range g.GetGroup().Zones {
+		if z.Id != nil {
+			ids = append(ids, *z.Id)
+		}
+	}
+
+	return ids, true, nil
+}
+
+// setGroupCloudIds replaces the clouds in a group
+func setGroupCloudIds(
+	ctx context.Context,
+	groupId int64,
+	cloudIds []int64,
+	client *sdk.APIClient,
+) error {
+	zones := []map[string]any{}
+	for _, id := range cloudIds {
+		zones = append(zones, map[string]any{"id": id})
+	}
+
+	zonesReq := sdk.NewUpdateGroupsZonesRequest(
+		*sdk.NewUpdateGroupsZonesRequestGroup(zones),
+	)
+
+	_, hresp, err := client.GroupsAPI.UpdateGroupsZones(ctx, groupId).
+		UpdateGroupsZonesRequest(*zonesReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"group %d zones PUT failed: %s", groupId, errors.ErrMsg(err, hresp),
+		)
+	}
+
+	return nil
+}
+
+// attachCloud adds a cloud to a group, leaving any other clouds in place
+func attachCloud(
+	ctx context.Context,
+	groupId int64,
+	cloudId int64,
+	client *sdk.APIClient,
+) error {
+	groupCloudsMu.Lock()
+	defer groupCloudsMu.Unlock()
+
+	ids, found, err := getGroupCloudIds(ctx, groupId, client)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("group %d not found", groupId)
+	}
+
+	if slices.Contains(ids, cloudId) {
+		return nil
+	}
+
+	err = setGroupCloudIds(ctx, groupId, append(ids, cloudId), client)
+	if err != nil {
+		return err
+	}
+
+	ids, _, err = getGroupCloudIds(ctx, groupId, client)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(ids, cloudId) {
+		return fmt.Errorf("cloud %d not in group %d after PUT", cloudId, groupId)
+	}
+
+	return nil
+}
+
+// detachCloud removes a cloud from a group, leaving any other clouds in
+// place
+func detachCloud(
+	ctx context.Context,
+	groupId int64,
+	cloudId int64,
+	client *sdk.APIClient,
+) error {
+	groupCloudsMu.Lock()
+	defer groupCloudsMu.Unlock()
+
+	ids, found, err := getGroupCloudIds(ctx, groupId, client)
+	if err != nil {
+		return err
+	}
+
+	// nothing to do if the group or the attachment is already gone
+	if !found || !slices.Contains(ids, cloudId) {
+		return nil
+	}
+
+	ids = slices.DeleteFunc(ids, func(id int64) bool { return id == cloudId })
+
+	err = setGroupCloudIds(ctx, groupId, ids, client)
+	if err != nil {
+		return err
+	}
+
+	ids, _, err = getGroupCloudIds(ctx, groupId, client)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(ids, cloudId) {
+		return fmt.Errorf("cloud %d still in group %d after PUT", cloudId, groupId)
+	}
+
+	return nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan GroupCloudAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := plan.GroupId.ValueInt64()
+	cloudId := plan.CloudId.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create group cloud attachment resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	if err := attachCloud(ctx, groupId, cloudId, client); err != nil {
+		resp.Diagnostics.AddError(
+			"create group cloud attachment resource",
+			fmt.Sprintf("attaching cloud %d: ", cloudId)+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			GroupId: plan.GroupId,
+			CloudId: plan.CloudId,
+		})...,
+	)
+}
+
+// Update is never called with changes, as all attributes force replacement
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan GroupCloudAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupCloudAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read group cloud attachment resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	groupId := data.GroupId.ValueInt64()
+	cloudId := data.CloudId.ValueInt64()
+
+	ids, found, err := getGroupCloudIds(ctx, groupId, client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read group cloud attachment resource",
+			err.Error(),
+		)
+
+		return
+	}
+
+	// the cloud was detached outside of terraform, so plan to reattach it
+	if !found || !slices.Contains(ids, cloudId) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			GroupId: data.GroupId,
+			CloudId: data.CloudId,
+		})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupCloudAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := data.GroupId.ValueInt64()
+	cloudId := data.CloudId.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete group cloud attachment resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	if err := detachCloud(ctx, groupId, cloudId, client); err != nil {
+		resp.Diagnostics.AddError(
+			"delete group cloud attachment resource",
+			fmt.Sprintf("detaching cloud %d: ", cloudId)+err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		var m identityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("group_id"), m.GroupId)...,
+		)
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("cloud_id"), m.CloudId)...,
+		)
+
+		return
+	}
+
+	groupIdStr, cloudIdStr, found := strings.Cut(req.ID, "/")
+	groupId, groupErr := strconv.ParseInt(groupIdStr, 10, 64)
+	cloudId, cloudErr := strconv.ParseInt(cloudIdStr, 10, 64)
+	if !found || groupErr != nil || cloudErr != nil {
+		resp.Diagnostics.AddError(
+			"import group cloud attachment resource",
+			"provided import ID '"+req.ID+"' is invalid (expected <group_id>/<cloud_id>)",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...,
+	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("cloud_id"), cloudId)...,
+	)
+}
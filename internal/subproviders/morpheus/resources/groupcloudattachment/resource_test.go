@@ -0,0 +1,111 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl GroupName "Shared group" CloudId 1
+
+package groupcloudattachment_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+func importStateId(s *terraform.State) (string, error) {
+	groupId, err := testhelpers.ExtractValue(
+		s, "hpe_morpheus_group_cloud_attachment.example", "group_id")
+	if err != nil {
+		return "", err
+	}
+
+	cloudId, err := testhelpers.ExtractValue(
+		s, "hpe_morpheus_group_cloud_attachment.example", "cloud_id")
+	if err != nil {
+		return "", err
+	}
+
+	return groupId + "/" + cloudId, nil
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusGroupCloudAttachmentExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"GroupName", name,
+		"CloudId", "4617")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_group_cloud_attachment.example",
+			"group_id",
+			"hpe_morpheus_group.example",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_group_cloud_attachment.example",
+			"cloud_id",
+			"4617",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_group_cloud_attachment.example",
+				ImportStateIdFunc: importStateId,
+				// there's no id attribute, the cloud id identifies the
+				// attachment within the group
+				ImportStateVerifyIdentifierAttribute: "cloud_id",
+				Check:                                checkFn,
+			},
+		},
+	})
+}
@@ -0,0 +1,12 @@
+resource "hpe_morpheus_key_pair" "example" {
+  name       = "example"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHEzRaPXQ7AkckOWxnUxOt3YMQJMP/fgQdaocQXpX02C example"
+}
+
+resource "hpe_morpheus_user" "example" {
+  username          = "example"
+  email             = "example@example.com"
+  password_wo       = "Secret123!"
+  role_ids          = [3]
+  linux_key_pair_id = hpe_morpheus_key_pair.example.id
+}
@@ -0,0 +1,358 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package keypair
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_key_pair"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = KeyPairResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// keyPairFromResponse returns the key pair from a GET or POST response. The
+// sdk models it under "account", but the api returns it under "keyPair",
+// which the sdk leaves in AdditionalProperties.
+func keyPairFromResponse(
+	account *sdk.AddKeyPairs200ResponseAllOfAccount,
+	additional map[string]any,
+) (*sdk.KeyPair, error) {
+	var raw any
+	if kp, ok := additional["keyPair"]; ok {
+		raw = kp
+	} else if account != nil {
+		raw = account
+	} else {
+		return nil, fmt.Errorf("key pair missing from response")
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyPair sdk.KeyPair
+	if err := json.Unmarshal(b, &keyPair); err != nil {
+		return nil, err
+	}
+
+	return &keyPair, nil
+}
+
+// populate key pair resource model with current API values. The public key
+// in prior is kept when it only differs from the api by surrounding
+// whitespace, to avoid replacing the key pair. The returned bool is false if
+// the key pair no longer exists.
+func getKeyPairAsState(
+	ctx context.Context,
+	id int64,
+	prior KeyPairModel,
+	client *sdk.APIClient,
+) (KeyPairModel, bool, diag.Diagnostics) {
+	var state KeyPairModel
+	var diags diag.Diagnostics
+
+	k, hresp, err := client.KeyPairsAPI.GetKeyPairs(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate key pair resource",
+			fmt.Sprintf("key pair %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	keyPair, err := keyPairFromResponse(k.Account, k.AdditionalProperties)
+	if err != nil {
+		diags.AddError(
+			"populate key pair resource",
+			fmt.Sprintf("key pair %d: %s", id, err.Error()),
+		)
+
+		return state, true, diags
+	}
+
+	state.Id = convert.Int64ToType(keyPair.Id)
+	state.Name = convert.StrToType(keyPair.Name)
+	state.PublicKey = convert.StrToType(keyPair.PublicKey.Get())
+	state.Fingerprint = convert.StrToType(keyPair.Fingerprint.Get())
+	state.HasPrivateKey = convert.BoolToType(keyPair.HasPrivateKey)
+
+	if strings.TrimSpace(prior.PublicKey.ValueString()) ==
+		strings.TrimSpace(state.PublicKey.ValueString()) {
+		state.PublicKey = prior.PublicKey
+	}
+
+	// special case - can't read from API
+	state.PrivateKeyWoVersion = prior.PrivateKeyWoVersion
+
+	return state, true, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan, config KeyPairModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	addKeyPair := sdk.NewAddKeyPairsRequestKeyPair(name, plan.PublicKey.ValueString())
+
+	if !config.PrivateKeyWo.IsNull() {
+		addKeyPair.SetPrivateKey(config.PrivateKeyWo.ValueString())
+	}
+
+	if !config.PassphraseWo.IsNull() {
+		addKeyPair.SetPassphrase(config.PassphraseWo.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create key pair resource",
+			"key pair "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addKeyPairReq := sdk.NewAddKeyPairsRequest(*addKeyPair)
+
+	k, hresp, err := client.KeyPairsAPI.AddKeyPairs(ctx).
+		AddKeyPairsRequest(*addKeyPairReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create key pair resource",
+			"key pair "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	keyPair, err := keyPairFromResponse(k.Account, k.AdditionalProperties)
+	if err != nil || keyPair.Id == nil {
+		resp.Diagnostics.AddError(
+			"create key pair resource",
+			"key pair "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *keyPair.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getKeyPairAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create key pair resource",
+			fmt.Sprintf("key pair %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is only reached when nothing but write only attributes have
+// changed, as every other attribute replaces the key pair. There is no
+// update api, so the plan is saved as is.
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan KeyPairModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data KeyPairModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read key pair resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getKeyPairAsState(ctx, id, data, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read key pair resource",
+			fmt.Sprintf("key pair %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the key pair was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data KeyPairModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete key pair resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.KeyPairsAPI.RemoveKeyPairs(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete key pair resource",
+			fmt.Sprintf("key pair %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import key pair resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,159 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "example" PublicKey "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHEzRaPXQ7AkckOWxnUxOt3YMQJMP/fgQdaocQXpX02C example" Username "example"
+
+package keypair_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+const testPublicKey = "ssh-ed25519 " +
+	"AAAAC3NzaC1lZDI1NTE5AAAAIHEzRaPXQ7AkckOWxnUxOt3YMQJMP/fgQdaocQXpX02C example"
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusKeyPairExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+	username := strings.ToLower(acctest.RandomWithPrefix("testacc-keypair"))
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"PublicKey", testPublicKey,
+		"Username", username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_key_pair.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_key_pair.example",
+			"public_key",
+			testPublicKey,
+		),
+		resource.TestCheckResourceAttrSet(
+			"hpe_morpheus_key_pair.example",
+			"fingerprint",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_key_pair.example",
+			"has_private_key",
+			"false",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_user.example",
+			"linux_key_pair_id",
+			"hpe_morpheus_key_pair.example",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_key_pair.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusKeyPairVersionReplaces(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	config := func(version int) string {
+		return providerConfig + fmt.Sprintf(`
+resource "hpe_morpheus_key_pair" "test" {
+  name                   = %q
+  public_key             = %q
+  private_key_wo_version = %d
+}`, name, testPublicKey, version)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.TestCheckResourceAttr(
+					"hpe_morpheus_key_pair.test", "private_key_wo_version", "1"),
+			},
+			{
+				Config:             config(1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: config(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_key_pair.test",
+							plancheck.ResourceActionDestroyBeforeCreate,
+						),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package keypair
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestKeyPairFromResponse(t *testing.T) {
+	t.Parallel()
+
+	body := `{
+		"keyPair": {
+			"id": 5,
+			"name": "example",
+			"publicKey": "ssh-ed25519 AAAA example",
+			"hasPrivateKey": true,
+			"fingerprint": "SHA256:abc"
+		}
+	}`
+
+	var resp sdk.GetKeyPairs200Response
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	keyPair, err := keyPairFromResponse(resp.Account, resp.AdditionalProperties)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), keyPair.GetId())
+	assert.Equal(t, "example", keyPair.GetName())
+	assert.Equal(t, "SHA256:abc", keyPair.GetFingerprint())
+	assert.True(t, keyPair.GetHasPrivateKey())
+}
+
+func TestKeyPairFromResponseMissing(t *testing.T) {
+	t.Parallel()
+
+	var resp sdk.GetKeyPairs200Response
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &resp))
+
+	_, err := keyPairFromResponse(resp.Account, resp.AdditionalProperties)
+	assert.Error(t, err)
+}
+
+func TestGetKeyPairAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getKeyPairAsState(context.Background(), 3, KeyPairModel{}, client)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
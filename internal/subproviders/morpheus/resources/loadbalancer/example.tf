@@ -0,0 +1,13 @@
+resource "hpe_morpheus_load_balancer" "example" {
+  name        = "Example load balancer"
+  description = "Web tier load balancer"
+  type_code   = "f5"
+  host        = "lb.example.internal"
+  port        = 443
+  visibility  = "private"
+
+  config = {
+    username = "admin"
+    password = "Secret123!"
+  }
+}
@@ -0,0 +1,441 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_load_balancer"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = LoadBalancerResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate load balancer resource model with current API values. The config
+// is not populated, as credentials are masked by the API, so callers carry
+// it over from the plan or prior state. The returned bool is false if the
+// load balancer no longer exists.
+func getLoadBalancerAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (LoadBalancerModel, bool, diag.Diagnostics) {
+	var state LoadBalancerModel
+	var diags diag.Diagnostics
+
+	lbId, err := convert.IdToFloat32(id)
+	if err != nil {
+		diags.AddError("populate load balancer resource", err.Error())
+
+		return state, true, diags
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	l, hresp, err := client.LoadBalancersAPI.GetLoadBalancer(ctx, lbId).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate load balancer resource",
+			fmt.Sprintf("load balancer %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	lb := l.GetLoadBalancer()
+
+	state.Id = convert.Int64ToType(lb.Id)
+	state.Name = convert.StrToType(lb.Name)
+	state.TypeCode = convert.StrToType(lb.GetType().Code)
+	state.Visibility = convert.StrToType(lb.Visibility)
+	state.Description = convert.StrToTypeEmptyNull(lb.Description)
+	state.Host = convert.StrToTypeEmptyNull(lb.Host)
+	state.Port = convert.Int64ToType(lb.Port)
+	state.Config = types.DynamicNull()
+
+	if lb.Cloud != nil {
+		state.CloudId = convert.Int64ToType(lb.Cloud.Id)
+	} else {
+		state.CloudId = types.Int64Null()
+	}
+
+	return state, true, diags
+}
+
+// connectionProperties returns the api connection settings, which the sdk
+// request types don't model
+func connectionProperties(plan LoadBalancerModel) map[string]any {
+	properties := map[string]any{}
+
+	if !plan.Host.IsNull() {
+		properties["host"] = plan.Host.ValueString()
+	}
+
+	if !plan.Port.IsNull() {
+		properties["port"] = plan.Port.ValueInt64()
+	}
+
+	return properties
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan LoadBalancerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create load balancer resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	config, err := convert.DynamicToMap(ctx, plan.Config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create load balancer resource",
+			"load balancer "+name+": failed to convert config: "+err.Error(),
+		)
+
+		return
+	}
+
+	lb := sdk.NewCreateLoadBalancerRequestLoadBalancer()
+	lb.SetName(name)
+	lb.SetVisibility(plan.Visibility.ValueString())
+	if !plan.Description.IsNull() {
+		lb.SetDescription(plan.Description.ValueString())
+	}
+	if config != nil {
+		lb.SetConfig(config)
+	}
+
+	lb.AdditionalProperties = connectionProperties(plan)
+	lb.AdditionalProperties["type"] = map[string]any{"code": plan.TypeCode.ValueString()}
+	if !plan.CloudId.IsNull() {
+		lb.AdditionalProperties["cloud"] = map[string]any{"id": plan.CloudId.ValueInt64()}
+	}
+
+	createLbReq := sdk.NewCreateLoadBalancerRequest()
+	createLbReq.SetLoadBalancer(*lb)
+
+	l, hresp, err := client.LoadBalancersAPI.CreateLoadBalancer(ctx).
+		CreateLoadBalancerRequest(*createLbReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create load balancer resource",
+			"load balancer "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if l.GetLoadBalancer().Id == nil {
+		resp.Diagnostics.AddError(
+			"create load balancer resource",
+			"load balancer "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *l.GetLoadBalancer().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getLoadBalancerAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create load balancer resource",
+			fmt.Sprintf("load balancer %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.Config = plan.Config
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan LoadBalancerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update load balancer resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	lbId, err := convert.IdToFloat32(id)
+	if err != nil {
+		resp.Diagnostics.AddError("update load balancer resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	config, err := convert.DynamicToMap(ctx, plan.Config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update load balancer resource",
+			fmt.Sprintf("load balancer %d: failed to convert config: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	lb := sdk.NewUpdateLoadBalancerRequestLoadBalancer()
+	lb.SetName(plan.Name.ValueString())
+	lb.SetVisibility(plan.Visibility.ValueString())
+	// an empty string clears the description
+	lb.SetDescription(plan.Description.ValueString())
+	if config != nil {
+		lb.SetConfig(config)
+	}
+
+	lb.AdditionalProperties = connectionProperties(plan)
+
+	updateLbReq := sdk.NewUpdateLoadBalancerRequest()
+	updateLbReq.SetLoadBalancer(*lb)
+
+	_, hresp, err := client.LoadBalancersAPI.UpdateLoadBalancer(ctx, lbId).
+		UpdateLoadBalancerRequest(*updateLbReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update load balancer resource",
+			fmt.Sprintf("load balancer %d PUT failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getLoadBalancerAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update load balancer resource",
+			fmt.Sprintf("load balancer %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.Config = plan.Config
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data LoadBalancerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read load balancer resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getLoadBalancerAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read load balancer resource",
+			fmt.Sprintf("load balancer %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the load balancer was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if !data.Config.IsUnknown() {
+		state.Config = data.Config
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data LoadBalancerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	lbId, err := convert.IdToFloat32(id)
+	if err != nil {
+		resp.Diagnostics.AddError("delete load balancer resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete load balancer resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.LoadBalancersAPI.DeleteLoadBalancer(ctx, lbId).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete load balancer resource",
+			fmt.Sprintf("load balancer %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import load balancer resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,174 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example load balancer" Description "Web tier load balancer" Host "lb.example.internal" Port 443
+
+package loadbalancer_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusLoadBalancerExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "test load balancer",
+		"Host", "lb.example.internal",
+		"Port", "443")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"description",
+			"test load balancer",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"type_code",
+			"f5",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"host",
+			"lb.example.internal",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"port",
+			"443",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer.example",
+			"visibility",
+			"private",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_load_balancer.example",
+				// credentials are masked by the api, so config is not read
+				ImportStateVerifyIgnore: []string{"config"},
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusLoadBalancerUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_load_balancer" "test" {
+  name        = "` + name + `"
+  description = "before"
+  type_code   = "f5"
+  host        = "lb.example.internal"
+  port        = 443
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer.test", "description", "before"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the load balancer is updated in place and the description is cleared
+resource "hpe_morpheus_load_balancer" "test" {
+  name       = "` + name + `-renamed"
+  type_code  = "f5"
+  host       = "lb2.example.internal"
+  port       = 8443
+  visibility = "public"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_load_balancer.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer.test", "host", "lb2.example.internal"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer.test", "port", "8443"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer.test", "visibility", "public"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_load_balancer.test", "description"),
+				),
+			},
+		},
+	})
+}
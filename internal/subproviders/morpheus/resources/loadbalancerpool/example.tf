@@ -0,0 +1,32 @@
+resource "hpe_morpheus_load_balancer" "example" {
+  name      = "Example load balancer"
+  type_code = "f5"
+  host      = "lb.example.internal"
+  port      = 443
+
+  config = {
+    username = "admin"
+    password = "Secret123!"
+  }
+}
+
+resource "hpe_morpheus_load_balancer_pool" "example" {
+  load_balancer_id = hpe_morpheus_load_balancer.example.id
+  name             = "web"
+  description      = "Web tier pool"
+  vip_balance      = "roundrobin"
+
+  members = [
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8080
+    },
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8081
+      weight      = 2
+    },
+  ]
+}
@@ -0,0 +1,86 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package loadbalancerpool
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func member(instanceId int64, ipAddress string, port int64, weight int64) MembersValue {
+	return MembersValue{
+		InstanceId: types.Int64Value(instanceId),
+		IpAddress:  types.StringValue(ipAddress),
+		Port:       types.Int64Value(port),
+		Weight:     types.Int64Value(weight),
+		state:      attr.ValueStateKnown,
+	}
+}
+
+func TestDiffMembers(t *testing.T) {
+	t.Parallel()
+
+	body := `[
+		{"id": 1, "instanceId": 10, "ipAddress": "10.0.0.10", "port": 80, "weight": 1},
+		{"id": 2, "instanceId": 11, "ipAddress": "10.0.0.11", "port": 80, "weight": 1},
+		{"id": 3, "instanceId": 12, "ipAddress": "10.0.0.12", "port": 80, "weight": 1}
+	]`
+
+	var nodes []poolNode
+	require.NoError(t, json.Unmarshal([]byte(body), &nodes))
+
+	changes := diffMembers([]MembersValue{
+		// unchanged
+		member(10, "10.0.0.10", 80, 1),
+		// weight changed
+		member(11, "10.0.0.11", 80, 5),
+		// port changed, so replaced
+		member(12, "10.0.0.12", 8080, 1),
+		// added
+		member(13, "10.0.0.13", 80, 1),
+	}, nodes)
+
+	assert.ElementsMatch(t, []MembersValue{
+		member(12, "10.0.0.12", 8080, 1),
+		member(13, "10.0.0.13", 80, 1),
+	}, changes.create)
+	assert.Equal(t, map[int64]MembersValue{2: member(11, "10.0.0.11", 80, 5)}, changes.update)
+	assert.Equal(t, []int64{3}, changes.remove)
+}
+
+func TestDiffMembersNone(t *testing.T) {
+	t.Parallel()
+
+	var nodes []poolNode
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": 1, "instanceId": 10, "ipAddress": "10.0.0.10", "port": 80, "weight": 1}
+	]`), &nodes))
+
+	changes := diffMembers(nil, nodes)
+
+	assert.Empty(t, changes.create)
+	assert.Empty(t, changes.update)
+	assert.Equal(t, []int64{1}, changes.remove)
+}
+
+func TestNodeToMember(t *testing.T) {
+	t.Parallel()
+
+	var nodes []poolNode
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": 1, "ipAddress": "10.0.0.10", "port": 80, "weight": null, "instanceId": null}
+	]`), &nodes))
+
+	m := nodeToMember(nodes[0])
+	assert.True(t, m.InstanceId.IsNull())
+	assert.True(t, m.Weight.IsNull())
+	assert.Equal(t, "10.0.0.10", m.IpAddress.ValueString())
+	assert.Equal(t, int64(80), m.Port.ValueInt64())
+}
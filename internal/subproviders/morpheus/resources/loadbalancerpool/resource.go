@@ -0,0 +1,649 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package loadbalancerpool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// poolNode is a pool member as modelled by the api
+type poolNode = sdk.ListLoadBalancerPoolNodes200ResponseAllOfLoadBalancerNodesInner
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+// pools are addressed by load balancer and pool id
+type identityModel struct {
+	LoadBalancerId types.Int64 `tfsdk:"load_balancer_id"`
+	Id             types.Int64 `tfsdk:"id"`
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_load_balancer_pool"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = LoadBalancerPoolResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"load_balancer_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the load balancer",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the resource",
+			},
+		},
+	}
+}
+
+// listPoolNodes returns all members of a pool
+func listPoolNodes(
+	ctx context.Context,
+	poolId int64,
+	client *sdk.APIClient,
+) ([]poolNode, error) {
+	nodesPoolId, err := convert.IdToFloat32(poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, poolId)
+
+	return paginate.All(ctx, func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]poolNode, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		ns, hresp, err := client.LoadBalancersAPI.
+			ListLoadBalancerPoolNodes(ctx, nodesPoolId).
+			Max(pageSize).Offset(offset).Execute()
+		if ns == nil || err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf(
+				"GET failed for pool %d members: %s", poolId, errors.ErrMsg(err, hresp),
+			)
+		}
+
+		return ns.GetLoadBalancerNodes(), ns.Meta, nil
+	})
+}
+
+func nodeToMember(node poolNode) MembersValue {
+	instanceId := types.Int64Null()
+	if node.InstanceId.Get() != nil {
+		instanceId = types.Int64Value(*node.InstanceId.Get())
+	}
+
+	weight := types.Int64Null()
+	if node.Weight.Get() != nil {
+		weight = types.Int64Value(*node.Weight.Get())
+	}
+
+	return MembersValue{
+		InstanceId: instanceId,
+		IpAddress:  convert.StrToType(node.IpAddress),
+		Port:       convert.Int64ToType(node.Port),
+		Weight:     weight,
+		state:      attr.ValueStateKnown,
+	}
+}
+
+// populate load balancer pool resource model with current API values. The
+// returned bool is false if the pool no longer exists.
+func getLoadBalancerPoolAsState(
+	ctx context.Context,
+	lbId int64,
+	id int64,
+	client *sdk.APIClient,
+) (LoadBalancerPoolModel, bool, diag.Diagnostics) {
+	var state LoadBalancerPoolModel
+	var diags diag.Diagnostics
+
+	poolLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		diags.AddError("populate load balancer pool resource", err.Error())
+
+		return state, true, diags
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	p, hresp, err := client.LoadBalancersAPI.
+		GetLoadBalancerPool(ctx, poolLbId, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d GET failed: ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	pool := p.GetLoadBalancerPool()
+
+	state.Id = convert.Int64ToType(pool.Id)
+	state.LoadBalancerId = types.Int64Value(lbId)
+	state.Name = convert.StrToType(pool.Name)
+	state.VipBalance = convert.StrToType(pool.VipBalance)
+	state.MinActive = convert.Int64ToType(pool.MinActive)
+
+	state.Description = convert.StrToTypeEmptyNull(pool.Description.Get())
+
+	nodes, err := listPoolNodes(ctx, id, client)
+	if err != nil {
+		diags.AddError("populate load balancer pool resource", err.Error())
+
+		return state, true, diags
+	}
+
+	members, d := convert.ToSetType(ctx, nodes, nodeToMember)
+	diags.Append(d...)
+	state.Members = members
+
+	return state, true, diags
+}
+
+// memberKey identifies a pool member by the instance and address traffic is
+// sent to
+func memberKey(instanceId int64, ipAddress string, port int64) string {
+	return fmt.Sprintf("%d/%s:%d", instanceId, ipAddress, port)
+}
+
+// memberChanges are the api calls needed to bring the pool members in line
+// with the plan
+type memberChanges struct {
+	create []MembersValue
+	// members whose weight has changed, keyed by node id
+	update map[int64]MembersValue
+	remove []int64
+}
+
+// diffMembers compares planned members with the existing pool nodes.
+// Members are matched by memberKey, so only changes of weight are made in
+// place.
+func diffMembers(members []MembersValue, nodes []poolNode) memberChanges {
+	changes := memberChanges{update: map[int64]MembersValue{}}
+
+	existing := map[string]poolNode{}
+	for _, n := range nodes {
+		existing[memberKey(n.GetInstanceId(), n.GetIpAddress(), n.GetPort())] = n
+	}
+
+	for _, m := range members {
+		key := memberKey(m.InstanceId.ValueInt64(), m.IpAddress.ValueString(), m.Port.ValueInt64())
+
+		n, ok := existing[key]
+		if !ok {
+			changes.create = append(changes.create, m)
+
+			continue
+		}
+
+		delete(existing, key)
+
+		if n.Weight.Get() == nil || *n.Weight.Get() != m.Weight.ValueInt64() {
+			changes.update[n.GetId()] = m
+		}
+	}
+
+	for _, n := range existing {
+		changes.remove = append(changes.remove, n.GetId())
+	}
+
+	return changes
+}
+
+func newNode(m MembersValue) *sdk.CreateLoadBalancerPoolNodeRequest {
+	node := sdk.NewCreateLoadBalancerPoolNodeRequestLoadBalancerNode()
+	node.SetIpAddress(m.IpAddress.ValueString())
+	// port and weight are bounded by the schema validators
+	node.SetPort(int32(m.Port.ValueInt64()))     //nolint:gosec
+	node.SetWeight(int32(m.Weight.ValueInt64())) //nolint:gosec
+	// the sdk request type doesn't model the instance
+	node.AdditionalProperties = map[string]any{
+		"instanceId": m.InstanceId.ValueInt64(),
+	}
+
+	nodeReq := sdk.NewCreateLoadBalancerPoolNodeRequest()
+	nodeReq.SetLoadBalancerNode(*node)
+
+	return nodeReq
+}
+
+// setMembers creates, updates and removes pool nodes to match the planned
+// members
+func setMembers(
+	ctx context.Context,
+	poolId int64,
+	plan LoadBalancerPoolModel,
+	client *sdk.APIClient,
+) error {
+	var members []MembersValue
+	if !plan.Members.IsNull() {
+		if d := plan.Members.ElementsAs(ctx, &members, false); d.HasError() {
+			return fmt.Errorf("pool %d: failed to read members", poolId)
+		}
+	}
+
+	nodes, err := listPoolNodes(ctx, poolId, client)
+	if err != nil {
+		return err
+	}
+
+	nodesPoolId, err := convert.IdToFloat32(poolId)
+	if err != nil {
+		return err
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, poolId)
+
+	changes := diffMembers(members, nodes)
+
+	for _, nodeId := range changes.remove {
+		_, hresp, err := client.LoadBalancersAPI.
+			DeleteLoadBalancerPoolNode(ctx, nodesPoolId, nodeId).Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return fmt.Errorf(
+				"pool %d member %d DELETE failed: %s", poolId, nodeId, errors.ErrMsg(err, hresp),
+			)
+		}
+	}
+
+	for nodeId, m := range changes.update {
+		_, hresp, err := client.LoadBalancersAPI.
+			UpdateLoadBalancerPoolNode(ctx, nodesPoolId, nodeId).
+			CreateLoadBalancerPoolNodeRequest(*newNode(m)).Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return fmt.Errorf(
+				"pool %d member %d PUT failed: %s", poolId, nodeId, errors.ErrMsg(err, hresp),
+			)
+		}
+	}
+
+	for _, m := range changes.create {
+		_, hresp, err := client.LoadBalancersAPI.
+			CreateLoadBalancerPoolNode(ctx, nodesPoolId).
+			CreateLoadBalancerPoolNodeRequest(*newNode(m)).Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return fmt.Errorf(
+				"pool %d member %s POST failed: %s", poolId, m.IpAddress.ValueString(),
+				errors.ErrMsg(err, hresp),
+			)
+		}
+	}
+
+	return nil
+}
+
+// newPool builds the request body shared by POST and PUT
+func newPool(plan LoadBalancerPoolModel) *sdk.CreateLoadBalancerPoolRequest {
+	pool := sdk.NewCreateLoadBalancerPoolRequestLoadBalancerPool()
+	pool.SetName(plan.Name.ValueString())
+	pool.SetVipBalance(plan.VipBalance.ValueString())
+	// an empty string clears the description
+	pool.SetDescription(plan.Description.ValueString())
+
+	if !plan.MinActive.IsNull() && !plan.MinActive.IsUnknown() {
+		pool.SetMinActive(plan.MinActive.ValueInt64())
+	}
+
+	poolReq := sdk.NewCreateLoadBalancerPoolRequest()
+	poolReq.SetLoadBalancerPool(*pool)
+
+	return poolReq
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan LoadBalancerPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create load balancer pool resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := plan.LoadBalancerId.ValueInt64()
+
+	poolLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("create load balancer pool resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	p, hresp, err := client.LoadBalancersAPI.CreateLoadBalancerPool(ctx, poolLbId).
+		CreateLoadBalancerPoolRequest(*newPool(plan)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool POST failed: ", lbId)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.GetLoadBalancerPool().Id == nil {
+		resp.Diagnostics.AddError(
+			"create load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool: id is nil", lbId),
+		)
+
+		return
+	}
+
+	id := *p.GetLoadBalancerPool().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: plan.LoadBalancerId,
+			Id:             plan.Id,
+		})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setMembers(ctx, id, plan, client); err != nil {
+		resp.Diagnostics.AddError("create load balancer pool resource", err.Error())
+
+		return
+	}
+
+	state, found, pdiags := getLoadBalancerPoolAsState(ctx, lbId, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan LoadBalancerPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update load balancer pool resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := plan.LoadBalancerId.ValueInt64()
+	id := plan.Id.ValueInt64()
+
+	poolLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("update load balancer pool resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	_, hresp, err := client.LoadBalancersAPI.UpdateLoadBalancerPool(ctx, poolLbId, id).
+		CreateLoadBalancerPoolRequest(*newPool(plan)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d PUT failed: ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if err := setMembers(ctx, id, plan, client); err != nil {
+		resp.Diagnostics.AddError("update load balancer pool resource", err.Error())
+
+		return
+	}
+
+	state, found, pdiags := getLoadBalancerPoolAsState(ctx, lbId, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: state.LoadBalancerId,
+			Id:             state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data LoadBalancerPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read load balancer pool resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := data.LoadBalancerId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	state, found, pdiags := getLoadBalancerPoolAsState(ctx, lbId, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	// the pool was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: state.LoadBalancerId,
+			Id:             state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data LoadBalancerPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lbId := data.LoadBalancerId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	poolLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("delete load balancer pool resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete load balancer pool resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.LoadBalancersAPI.
+		DeleteLoadBalancerPool(ctx, poolLbId, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete load balancer pool resource",
+			fmt.Sprintf("load balancer %d pool %d: DELETE failed ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		var m identityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), m.LoadBalancerId)...,
+		)
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), m.Id)...,
+		)
+
+		return
+	}
+
+	lbIdStr, idStr, found := strings.Cut(req.ID, "/")
+	lbId, lbErr := strconv.ParseInt(lbIdStr, 10, 64)
+	id, idErr := strconv.ParseInt(idStr, 10, 64)
+	if !found || lbErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"import load balancer pool resource",
+			"provided import ID '"+req.ID+"' is invalid (expected <load_balancer_id>/<id>)",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), lbId)...,
+	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), id)...,
+	)
+}
@@ -0,0 +1,221 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl LoadBalancerName "Example load balancer" Name "web" Description "Web tier pool" InstanceId 1 IpAddress "10.0.0.10"
+
+package loadbalancerpool_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// importId returns the <load_balancer_id>/<id> import id of a pool
+func importId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		return rs.Primary.Attributes["load_balancer_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func loadBalancerConfig(name string) string {
+	return `
+resource "hpe_morpheus_load_balancer" "test" {
+  name      = "` + name + `"
+  type_code = "f5"
+  host      = "lb.example.internal"
+  port      = 443
+}
+`
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusLoadBalancerPoolExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"LoadBalancerName", name,
+		"Name", name,
+		"Description", "test pool",
+		"InstanceId", "1",
+		"IpAddress", "10.0.0.10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_pool.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_pool.example",
+			"description",
+			"test pool",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_pool.example",
+			"members.#",
+			"2",
+		),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"hpe_morpheus_load_balancer_pool.example",
+			"members.*",
+			map[string]string{
+				"instance_id": "1",
+				"ip_address":  "10.0.0.10",
+				"port":        "8081",
+				"weight":      "2",
+			},
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_load_balancer_pool.example",
+				ImportStateIdFunc: importId("hpe_morpheus_load_balancer_pool.example"),
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusLoadBalancerPoolMembersOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + loadBalancerConfig(name) + `
+resource "hpe_morpheus_load_balancer_pool" "test" {
+  load_balancer_id = hpe_morpheus_load_balancer.test.id
+  name             = "` + name + `"
+
+  members = [
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8080
+    },
+  ]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_pool.test", "members.#", "1"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_pool.test", "vip_balance", "roundrobin"),
+				),
+			},
+			{
+				Config: providerConfig + loadBalancerConfig(name) + `
+# checks members are added and reweighted without replacing the pool
+resource "hpe_morpheus_load_balancer_pool" "test" {
+  load_balancer_id = hpe_morpheus_load_balancer.test.id
+  name             = "` + name + `"
+
+  members = [
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8080
+      weight      = 3
+    },
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8081
+    },
+  ]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_load_balancer_pool.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_pool.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"hpe_morpheus_load_balancer_pool.test",
+						"members.*",
+						map[string]string{"port": "8080", "weight": "3"},
+					),
+				),
+			},
+			{
+				Config: providerConfig + loadBalancerConfig(name) + `
+# checks all members can be removed
+resource "hpe_morpheus_load_balancer_pool" "test" {
+  load_balancer_id = hpe_morpheus_load_balancer.test.id
+  name             = "` + name + `"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_load_balancer_pool.test", "members"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+resource "hpe_morpheus_load_balancer" "example" {
+  name      = "Example load balancer"
+  type_code = "f5"
+  host      = "lb.example.internal"
+  port      = 443
+
+  config = {
+    username = "admin"
+    password = "Secret123!"
+  }
+}
+
+resource "hpe_morpheus_load_balancer_pool" "example" {
+  load_balancer_id = hpe_morpheus_load_balancer.example.id
+  name             = "web"
+
+  members = [
+    {
+      instance_id = 1
+      ip_address  = "10.0.0.10"
+      port        = 8080
+    },
+  ]
+}
+
+resource "hpe_morpheus_load_balancer_virtual_server" "example" {
+  load_balancer_id = hpe_morpheus_load_balancer.example.id
+  pool_id          = hpe_morpheus_load_balancer_pool.example.id
+  name             = "www"
+  description      = "Public web site"
+  vip_address      = "192.0.2.10"
+  vip_port         = 443
+  vip_protocol     = "https"
+  vip_hostname     = "www.example.com"
+}
@@ -0,0 +1,465 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package loadbalancervirtualserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+// virtual servers are addressed by load balancer and virtual server id
+type identityModel struct {
+	LoadBalancerId types.Int64 `tfsdk:"load_balancer_id"`
+	Id             types.Int64 `tfsdk:"id"`
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName +
+		"_load_balancer_virtual_server"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = LoadBalancerVirtualServerResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"load_balancer_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the load balancer",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the resource",
+			},
+		},
+	}
+}
+
+// populate virtual server resource model with current API values. The pool
+// is only returned by name, so callers carry pool_id over from the plan or
+// prior state. The returned bool is false if the virtual server no longer
+// exists.
+func getVirtualServerAsState(
+	ctx context.Context,
+	lbId int64,
+	id int64,
+	client *sdk.APIClient,
+) (LoadBalancerVirtualServerModel, bool, diag.Diagnostics) {
+	var state LoadBalancerVirtualServerModel
+	var diags diag.Diagnostics
+
+	vsLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		diags.AddError("populate load balancer virtual server resource", err.Error())
+
+		return state, true, diags
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	v, hresp, err := client.LoadBalancersAPI.
+		GetLoadBalancerVirtualServer(ctx, vsLbId, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d GET failed: ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	vs := v.GetLoadBalancerInstance()
+
+	state.Id = convert.Int64ToType(vs.Id)
+	state.LoadBalancerId = types.Int64Value(lbId)
+	state.Name = convert.StrToType(vs.VipName)
+	state.Description = convert.StrToTypeEmptyNull(vs.Description.Get())
+	state.VipAddress = convert.StrToType(vs.VipAddress)
+	state.VipPort = convert.Int64ToType(vs.VipPort)
+	state.VipProtocol = convert.StrToType(vs.VipProtocol)
+	state.VipHostname = convert.StrToTypeEmptyNull(vs.VipHostname.Get())
+	state.PoolId = types.Int64Null()
+
+	return state, true, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan LoadBalancerVirtualServerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create load balancer virtual server resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := plan.LoadBalancerId.ValueInt64()
+
+	vsLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("create load balancer virtual server resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	vs := sdk.NewCreateLoadBalancerVirtualServerRequestLoadBalancerInstance()
+	vs.SetVipName(plan.Name.ValueString())
+	vs.SetVipAddress(plan.VipAddress.ValueString())
+	vs.SetVipPort(plan.VipPort.ValueInt64())
+	vs.SetVipProtocol(plan.VipProtocol.ValueString())
+	if !plan.Description.IsNull() {
+		vs.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.VipHostname.IsNull() {
+		vs.SetVipHostname(plan.VipHostname.ValueString())
+	}
+	// the sdk only models the pool for PUT
+	if !plan.PoolId.IsNull() {
+		vs.AdditionalProperties = map[string]any{"pool": plan.PoolId.ValueInt64()}
+	}
+
+	createVsReq := sdk.NewCreateLoadBalancerVirtualServerRequest()
+	createVsReq.SetLoadBalancerInstance(*vs)
+
+	v, hresp, err := client.LoadBalancersAPI.CreateLoadBalancerVirtualServer(ctx, vsLbId).
+		CreateLoadBalancerVirtualServerRequest(*createVsReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server POST failed: ", lbId)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if v.GetLoadBalancerInstance().Id == nil {
+		resp.Diagnostics.AddError(
+			"create load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server: id is nil", lbId),
+		)
+
+		return
+	}
+
+	id := *v.GetLoadBalancerInstance().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: plan.LoadBalancerId,
+			Id:             plan.Id,
+		})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getVirtualServerAsState(ctx, lbId, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	state.PoolId = plan.PoolId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan LoadBalancerVirtualServerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update load balancer virtual server resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := plan.LoadBalancerId.ValueInt64()
+	id := plan.Id.ValueInt64()
+
+	vsLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("update load balancer virtual server resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	vs := sdk.NewUpdateLoadBalancerVirtualServerRequestLoadBalancerInstance()
+	vs.SetVipName(plan.Name.ValueString())
+	vs.SetVipAddress(plan.VipAddress.ValueString())
+	vs.SetVipPort(plan.VipPort.ValueInt64())
+	vs.SetVipProtocol(plan.VipProtocol.ValueString())
+	// empty strings clear the description and hostname
+	vs.SetDescription(plan.Description.ValueString())
+	vs.SetVipHostname(plan.VipHostname.ValueString())
+	if !plan.PoolId.IsNull() {
+		vs.SetPool(plan.PoolId.ValueInt64())
+	}
+
+	updateVsReq := sdk.NewUpdateLoadBalancerVirtualServerRequest()
+	updateVsReq.SetLoadBalancerInstance(*vs)
+
+	_, hresp, err := client.LoadBalancersAPI.UpdateLoadBalancerVirtualServer(ctx, vsLbId, id).
+		UpdateLoadBalancerVirtualServerRequest(*updateVsReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d PUT failed: ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getVirtualServerAsState(ctx, lbId, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	state.PoolId = plan.PoolId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: state.LoadBalancerId,
+			Id:             state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data LoadBalancerVirtualServerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read load balancer virtual server resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	lbId := data.LoadBalancerId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	state, found, pdiags := getVirtualServerAsState(ctx, lbId, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d: failed to read from api", lbId, id),
+		)
+
+		return
+	}
+
+	// the virtual server was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.PoolId = data.PoolId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			LoadBalancerId: state.LoadBalancerId,
+			Id:             state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data LoadBalancerVirtualServerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lbId := data.LoadBalancerId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	vsLbId, err := convert.IdToFloat32(lbId)
+	if err != nil {
+		resp.Diagnostics.AddError("delete load balancer virtual server resource", err.Error())
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, lbId)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete load balancer virtual server resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.LoadBalancersAPI.
+		DeleteLoadBalancerVirtualServer(ctx, vsLbId, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete load balancer virtual server resource",
+			fmt.Sprintf("load balancer %d virtual server %d: DELETE failed ", lbId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		var m identityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), m.LoadBalancerId)...,
+		)
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), m.Id)...,
+		)
+
+		return
+	}
+
+	lbIdStr, idStr, found := strings.Cut(req.ID, "/")
+	lbId, lbErr := strconv.ParseInt(lbIdStr, 10, 64)
+	id, idErr := strconv.ParseInt(idStr, 10, 64)
+	if !found || lbErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"import load balancer virtual server resource",
+			"provided import ID '"+req.ID+"' is invalid (expected <load_balancer_id>/<id>)",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("load_balancer_id"), lbId)...,
+	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), id)...,
+	)
+}
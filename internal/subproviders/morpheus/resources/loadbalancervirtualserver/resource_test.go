@@ -0,0 +1,208 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl LoadBalancerName "Example load balancer" PoolName "web" InstanceId 1 IpAddress "10.0.0.10" Name "www" Description "Public web site" VipAddress "192.0.2.10" VipHostname "www.example.com"
+
+package loadbalancervirtualserver_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// importId returns the <load_balancer_id>/<id> import id of a virtual server
+func importId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		return rs.Primary.Attributes["load_balancer_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusLoadBalancerVirtualServerExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"LoadBalancerName", name,
+		"PoolName", name,
+		"InstanceId", "1",
+		"IpAddress", "10.0.0.10",
+		"Name", name,
+		"Description", "test virtual server",
+		"VipAddress", "192.0.2.10",
+		"VipHostname", "www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"description",
+			"test virtual server",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"vip_address",
+			"192.0.2.10",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"vip_port",
+			"443",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"vip_protocol",
+			"https",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"vip_hostname",
+			"www.example.com",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_load_balancer_virtual_server.example",
+			"pool_id",
+			"hpe_morpheus_load_balancer_pool.example",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_load_balancer_virtual_server.example",
+				ImportStateIdFunc: importId("hpe_morpheus_load_balancer_virtual_server.example"),
+				// the pool is only returned by name, so pool_id is not read
+				ImportStateVerifyIgnore: []string{"pool_id"},
+			},
+		},
+	})
+}
+
+func TestAccMorpheusLoadBalancerVirtualServerUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	loadBalancerConfig := `
+resource "hpe_morpheus_load_balancer" "test" {
+  name      = "` + name + `"
+  type_code = "f5"
+  host      = "lb.example.internal"
+  port      = 443
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + loadBalancerConfig + `
+resource "hpe_morpheus_load_balancer_virtual_server" "test" {
+  load_balancer_id = hpe_morpheus_load_balancer.test.id
+  name             = "` + name + `"
+  description      = "before"
+  vip_address      = "192.0.2.10"
+  vip_port         = 80
+  vip_protocol     = "http"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_virtual_server.test", "description", "before"),
+				),
+			},
+			{
+				Config: providerConfig + loadBalancerConfig + `
+# checks the virtual server is updated in place and the description is cleared
+resource "hpe_morpheus_load_balancer_virtual_server" "test" {
+  load_balancer_id = hpe_morpheus_load_balancer.test.id
+  name             = "` + name + `-renamed"
+  vip_address      = "192.0.2.11"
+  vip_port         = 8080
+  vip_protocol     = "http"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_load_balancer_virtual_server.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_virtual_server.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_virtual_server.test", "vip_address", "192.0.2.11"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_load_balancer_virtual_server.test", "vip_port", "8080"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_load_balancer_virtual_server.test", "description"),
+				),
+			},
+		},
+	})
+}
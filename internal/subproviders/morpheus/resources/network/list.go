@@ -0,0 +1,167 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/listresult"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+var (
+	_ list.ListResource              = &ListResource{}
+	_ list.ListResourceWithConfigure = &ListResource{}
+)
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+// ListResource lists existing networks for terraform query
+type ListResource struct {
+	configure.ResourceWithMorpheusConfigure
+}
+
+type ListModel struct {
+	Name    types.String `tfsdk:"name"`
+	Phrase  types.String `tfsdk:"phrase"`
+	Label   types.String `tfsdk:"label"`
+	CloudId types.Int64  `tfsdk:"cloud_id"`
+}
+
+func (r *ListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_network"
+}
+
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list networks with this name",
+				MarkdownDescription: "Only list networks with this name",
+			},
+			"phrase": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list networks matching this search phrase",
+				MarkdownDescription: "Only list networks matching this search phrase",
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list networks with this label",
+				MarkdownDescription: "Only list networks with this label",
+			},
+			"cloud_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only list networks in this cloud (zone)",
+				MarkdownDescription: "Only list networks in this cloud (zone)",
+			},
+		},
+	}
+}
+
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config ListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		diags.AddError("list network resource", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	query := url.Values{}
+	if !config.Name.IsNull() {
+		query.Set("name", config.Name.ValueString())
+	}
+	if !config.Phrase.IsNull() {
+		query.Set("phrase", config.Phrase.ValueString())
+	}
+	if !config.Label.IsNull() {
+		query.Set("labels", config.Label.ValueString())
+	}
+	if !config.CloudId.IsNull() {
+		query.Set("zoneId", strconv.FormatInt(config.CloudId.ValueInt64(), 10))
+	}
+
+	fetch := func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListNetworks200ResponseAllOfNetworksInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		// the SDK request builder for ListNetworks has no max/offset
+		networks, hresp, err := paginate.GetPage[sdk.ListNetworks200Response](
+			ctx, client, "NetworksAPIService.ListNetworks", "/api/networks",
+			query, pageSize, offset,
+		)
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("networks GET failed: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return networks.GetNetworks(), networks.Meta, nil
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for n, err := range paginate.Seq(ctx, fetch) {
+			if err != nil {
+				push(listresult.Error("list network resource", err.Error()))
+
+				return
+			}
+
+			if n.Id == nil {
+				continue
+			}
+
+			id := *n.Id
+			result := listresult.New(ctx, req, id, n.GetName(),
+				func() (NetworkModel, diag.Diagnostics) {
+					return getNetworkAsState(ctx, id, client)
+				},
+			)
+			if !push(result) {
+				return
+			}
+
+			count++
+			if listresult.LimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/network"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testlist"
+)
+
+func TestListNetworks(t *testing.T) {
+	t.Parallel()
+
+	// more than one page of networks
+	const total = 150
+
+	handler := testlist.Handler(t, "/api/networks", "networks", "network", total,
+		func(id int64) map[string]any {
+			return map[string]any{
+				"id":          id,
+				"name":        fmt.Sprintf("network-%d", id),
+				"displayName": "Network",
+				"cidr":        "10.0.0.0/24",
+				"gateway":     "10.0.0.1",
+				"active":      true,
+				"visibility":  "private",
+				"zone":        map[string]any{"id": 2},
+				"type":        map[string]any{"id": 4},
+				"pool":        map[string]any{"id": 6},
+				"resourcePermission": map[string]any{
+					"all":   false,
+					"sites": []map[string]any{{"id": 8}},
+				},
+			}
+		},
+	)
+
+	results := testlist.Run(t,
+		network.NewListResource().(list.ListResourceWithConfigure),
+		network.NewResource().(resource.ResourceWithIdentity),
+		true, handler,
+	)
+	require.Len(t, results, total)
+
+	ctx := context.Background()
+	for i, result := range results {
+		id := int64(i + 1)
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, fmt.Sprintf("network-%d", id), result.DisplayName)
+
+		var ident identity.Model
+		require.False(t, result.Identity.Get(ctx, &ident).HasError())
+		assert.Equal(t, types.Int64Value(id), ident.Id)
+
+		var state network.NetworkModel
+		require.False(t, result.Resource.Get(ctx, &state).HasError())
+		assert.Equal(t, types.Int64Value(id), state.Id)
+		assert.Equal(t, types.StringValue(fmt.Sprintf("network-%d", id)), state.Name)
+		assert.Equal(t, types.StringValue("Network"), state.DisplayName)
+		assert.Equal(t, types.StringValue("10.0.0.0/24"), state.Cidr)
+		assert.Equal(t, types.StringValue("10.0.0.1"), state.Gateway)
+		assert.Equal(t, types.BoolValue(true), state.Active)
+		assert.Equal(t, types.StringValue("private"), state.Visibility)
+		assert.Equal(t, types.Int64Value(2), state.CloudId)
+		assert.Equal(t, types.Int64Value(4), state.TypeId)
+		assert.Equal(t, types.Int64Value(6), state.PoolId)
+		assert.False(t, state.ResourcePermissions.IsNull())
+	}
+}
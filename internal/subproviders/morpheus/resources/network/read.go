@@ -15,6 +15,8 @@ import (
 
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/permissions"
 )
 
 func getNetworkAsState(
@@ -198,7 +200,12 @@ func getNetworkAsState(
 
 	resourcePermission, ok := net.GetResourcePermissionOk()
 	if ok {
-		resourcePermissions, d := convertResourcePermissions(ctx, resourcePermission)
+		resourcePermissions, d := permissions.Value(
+			ctx,
+			resourcePermission,
+			ResourcePermissionsValue{}.AttributeTypes(ctx),
+			NewResourcePermissionsValue,
+		)
 		diags.Append(d...)
 		if diags.HasError() {
 			return state, diags
@@ -211,46 +218,6 @@ func getNetworkAsState(
 	return state, diags
 }
 
-func convertResourcePermissions(
-	ctx context.Context,
-	resourcePermission *sdk.ListNetworks200ResponseAllOfNetworksInnerResourcePermission,
-) (ResourcePermissionsValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	var groupValues []attr.Value
-	sites, ok := resourcePermission.GetSitesOk()
-	if ok {
-		for _, site := range sites {
-			if site.Id != nil {
-				groupValues = append(
-					groupValues, types.Int64Value(*site.Id),
-				)
-			}
-		}
-	}
-
-	var groupIDsSet attr.Value
-	if len(groupValues) > 0 {
-		groupIDsSet, _ = types.SetValue(types.Int64Type, groupValues)
-	} else {
-		groupIDsSet = types.SetNull(types.Int64Type)
-	}
-
-	resourcePermissions, d := NewResourcePermissionsValue(
-		ResourcePermissionsValue{}.AttributeTypes(ctx),
-		map[string]attr.Value{
-			"all": types.BoolValue(
-				resourcePermission.All != nil &&
-					*resourcePermission.All,
-			),
-			"group_ids": groupIDsSet,
-		},
-	)
-	diags.Append(d...)
-
-	return resourcePermissions, diags
-}
-
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -286,4 +253,7 @@ func (r *Resource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
 }
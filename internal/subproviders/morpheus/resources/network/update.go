@@ -13,6 +13,7 @@ import (
 
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
 )
 
 func (r *Resource) Update(
@@ -253,4 +254,7 @@ func (r *Resource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &networkState)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: networkState.Id})...,
+	)
 }
@@ -0,0 +1,13 @@
+resource "hpe_morpheus_network_domain" "example" {
+  name              = "ad.example.com"
+  fqdn              = "ad.example.com"
+  description       = "Active Directory domain"
+  visibility        = "private"
+  domain_controller = true
+  domain_username   = "svc-join"
+  dc_server         = "dc01.ad.example.com"
+  ou_path           = "OU=servers,DC=ad,DC=example,DC=com"
+
+  domain_password_wo         = "Secret123!"
+  domain_password_wo_version = 1
+}
@@ -0,0 +1,445 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networkdomain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_network_domain"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = NetworkDomainResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// tenantIdsFromResponse reads the ids of the tenants the domain is shared
+// with, which the sdk response type doesn't model
+func tenantIdsFromResponse(additional map[string]any) types.Set {
+	tenants, _ := additional["tenants"].([]any)
+
+	var ids []int64
+	for _, t := range tenants {
+		tenant, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if id, ok := tenant["id"].(float64); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+
+	return convert.Int64SliceToSet(ids)
+}
+
+// populate network domain resource model with current API values. The
+// returned bool is false if the network domain no longer exists.
+func getNetworkDomainAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (NetworkDomainModel, bool, diag.Diagnostics) {
+	var state NetworkDomainModel
+	var diags diag.Diagnostics
+
+	d, hresp, err := client.NetworksAPI.GetNetworkDomain(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate network domain resource",
+			fmt.Sprintf("network domain %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	domain := d.GetNetworkDomain()
+
+	state.Id = convert.Int64ToType(domain.Id)
+	state.Name = convert.StrToType(domain.Name)
+	state.Active = convert.BoolToType(domain.Active)
+	state.Fqdn = convert.StrToTypeEmptyNull(domain.Fqdn.Get())
+	state.Description = convert.StrToTypeEmptyNull(domain.Description.Get())
+	state.Visibility = convert.StrToType(domain.Visibility)
+	state.PublicZone = convert.BoolToType(domain.PublicZone)
+	state.DomainController = convert.BoolToType(domain.DomainController)
+	state.DomainUsername = convert.StrToTypeEmptyNull(domain.DomainUsername.Get())
+	state.DcServer = convert.StrToTypeEmptyNull(domain.DcServer.Get())
+	state.OuPath = convert.StrToTypeEmptyNull(domain.OuPath.Get())
+	state.TenantIds = tenantIdsFromResponse(domain.AdditionalProperties)
+
+	return state, true, diags
+}
+
+// newNetworkDomain builds the request body shared by POST and PUT. Empty
+// strings are sent for unset attributes to clear them on update.
+func newNetworkDomain(
+	plan NetworkDomainModel,
+) (*sdk.CreateNetworkDomainRequestNetworkDomain, error) {
+	domain := sdk.NewCreateNetworkDomainRequestNetworkDomain()
+	domain.SetName(plan.Name.ValueString())
+	domain.SetDescription(plan.Description.ValueString())
+	domain.SetActive(plan.Active.ValueBool())
+	domain.SetPublicZone(plan.PublicZone.ValueBool())
+	domain.SetDomainController(plan.DomainController.ValueBool())
+	domain.SetDomainUsername(plan.DomainUsername.ValueString())
+	domain.SetDcServer(plan.DcServer.ValueString())
+	domain.SetOuPath(plan.OuPath.ValueString())
+
+	tenantIds, err := convert.SetToInt64Slice(plan.TenantIds)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := []map[string]int64{}
+	for _, id := range tenantIds {
+		tenants = append(tenants, map[string]int64{"id": id})
+	}
+
+	// these aren't modelled by the sdk request type
+	domain.AdditionalProperties = map[string]any{
+		"visibility": plan.Visibility.ValueString(),
+		"tenants":    tenants,
+	}
+
+	if !plan.Fqdn.IsUnknown() {
+		domain.AdditionalProperties["fqdn"] = plan.Fqdn.ValueString()
+	}
+
+	return domain, nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan, config NetworkDomainModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	domain, err := newNetworkDomain(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network domain resource",
+			"network domain "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	if !config.DomainPasswordWo.IsNull() {
+		domain.SetDomainPassword(config.DomainPasswordWo.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network domain resource",
+			"network domain "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addDomainReq := sdk.NewCreateNetworkDomainRequest()
+	addDomainReq.SetNetworkDomain(*domain)
+
+	d, hresp, err := client.NetworksAPI.CreateNetworkDomain(ctx).
+		CreateNetworkDomainRequest(*addDomainReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create network domain resource",
+			"network domain "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if d.GetNetworkDomain().Id == nil {
+		resp.Diagnostics.AddError(
+			"create network domain resource",
+			"network domain "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *d.GetNetworkDomain().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getNetworkDomainAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create network domain resource",
+			fmt.Sprintf("network domain %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.DomainPasswordWoVersion = plan.DomainPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state, config NetworkDomainModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	domain, err := newNetworkDomain(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network domain resource",
+			"network domain "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	if !plan.DomainPasswordWoVersion.Equal(state.DomainPasswordWoVersion) {
+		if config.DomainPasswordWo.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"update network domain resource",
+				fmt.Sprintf("network domain %s: 'domain_password_wo_version' changed, "+
+					"but 'domain_password_wo' is not set", name),
+			)
+
+			return
+		}
+		// an empty password clears it
+		domain.SetDomainPassword(config.DomainPasswordWo.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network domain resource",
+			"network domain "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateDomainReq := sdk.NewCreateNetworkDomainRequest()
+	updateDomainReq.SetNetworkDomain(*domain)
+
+	_, hresp, err := client.NetworksAPI.UpdateNetworkDomain(ctx, id).
+		CreateNetworkDomainRequest(*updateDomainReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update network domain resource",
+			"network domain "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	newState, found, pdiags := getNetworkDomainAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update network domain resource",
+			fmt.Sprintf("network domain %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	newState.DomainPasswordWoVersion = plan.DomainPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: newState.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NetworkDomainModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read network domain resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getNetworkDomainAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read network domain resource",
+			fmt.Sprintf("network domain %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the network domain was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.DomainPasswordWoVersion = data.DomainPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NetworkDomainModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete network domain resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteNetworkDomain(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete network domain resource",
+			fmt.Sprintf("network domain %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import network domain resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
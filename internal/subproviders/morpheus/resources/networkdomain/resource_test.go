@@ -0,0 +1,179 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "ad.example.com" Description "Active Directory domain" Visibility "private" DomainUsername "svc-join" DcServer "dc01.ad.example.com" OuPath "OU=servers,DC=ad,DC=example,DC=com"
+
+package networkdomain_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusNetworkDomainExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := strings.ToLower(acctest.RandomWithPrefix("tfacc")) + ".example.com"
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "An example network domain",
+		"Visibility", "public",
+		"DomainUsername", "svc-join",
+		"DcServer", "dc01.example.com",
+		"OuPath", "OU=servers,DC=example,DC=com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"fqdn",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"domain_controller",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"domain_username",
+			"svc-join",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"ou_path",
+			"OU=servers,DC=example,DC=com",
+		),
+		resource.TestCheckNoResourceAttr(
+			"hpe_morpheus_network_domain.example",
+			"domain_password_wo",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_domain.example",
+				// not returned by the api
+				ImportStateVerifyIgnore: []string{"domain_password_wo_version"},
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkDomainUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := strings.ToLower(acctest.RandomWithPrefix("tfacc")) + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_network_domain" "test" {
+  name        = "` + name + `"
+  description = "before"
+  tenant_ids  = [1]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "active", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "tenant_ids.#", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the domain is updated in place and the description
+# and tenants are cleared
+resource "hpe_morpheus_network_domain" "test" {
+  name            = "` + name + `"
+  active          = false
+  visibility      = "public"
+  domain_username = "svc-join"
+
+  domain_password_wo         = "Secret123!"
+  domain_password_wo_version = 1
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "active", "false"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_domain.test", "domain_password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_domain.test", "description"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_domain.test", "tenant_ids"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+resource "hpe_morpheus_group" "example" {
+  name = "Example group"
+}
+
+resource "hpe_morpheus_network_group" "example" {
+  name        = "Example network group"
+  description = "Networks shared by the example group"
+  visibility  = "private"
+
+  resource_permissions = {
+    all       = false
+    group_ids = [hpe_morpheus_group.example.id]
+  }
+}
@@ -0,0 +1,443 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networkgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/permissions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_network_group"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = NetworkGroupResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// idsFromMaps reads the "id" of each object, as decoded from json
+func idsFromMaps(items []map[string]any) []int64 {
+	var ids []int64
+	for _, item := range items {
+		if id, ok := item["id"].(float64); ok {
+			ids = append(ids, int64(id))
+		}
+	}
+
+	return ids
+}
+
+// populate network group resource model with current API values. The
+// returned bool is false if the network group no longer exists.
+func getNetworkGroupAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (NetworkGroupModel, bool, diag.Diagnostics) {
+	var state NetworkGroupModel
+	var diags diag.Diagnostics
+
+	g, hresp, err := client.NetworksAPI.GetNetworkGroup(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate network group resource",
+			fmt.Sprintf("network group %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	group := g.GetNetworkGroup()
+
+	state.Id = convert.Int64ToType(group.Id)
+	state.Name = convert.StrToType(group.Name)
+	state.Visibility = convert.StrToType(group.Visibility)
+	state.NetworkIds = convert.Int64SliceToSet(group.Networks)
+	state.SubnetIds = convert.Int64SliceToSet(idsFromMaps(group.Subnets))
+
+	state.Description = convert.StrToTypeEmptyNull(group.Description)
+
+	// the sdk response type doesn't model the group permissions
+	resourcePermission, ok := group.AdditionalProperties["resourcePermission"].(map[string]any)
+	if !ok {
+		resourcePermission, ok = group.AdditionalProperties["resourcePermissions"].(map[string]any)
+	}
+	if ok {
+		resourcePermissions, d := permissions.Value(
+			ctx,
+			resourcePermission,
+			ResourcePermissionsValue{}.AttributeTypes(ctx),
+			NewResourcePermissionsValue,
+		)
+		diags.Append(d...)
+		state.ResourcePermissions = resourcePermissions
+	} else {
+		state.ResourcePermissions = NewResourcePermissionsValueNull()
+	}
+
+	return state, true, diags
+}
+
+// newNetworkGroup builds the request body shared by POST and PUT. Empty
+// values are sent for unset attributes to clear them on update.
+func newNetworkGroup(
+	ctx context.Context,
+	plan NetworkGroupModel,
+) (*sdk.CreateNetworkGroupRequestNetworkGroup, error) {
+	group := sdk.NewCreateNetworkGroupRequestNetworkGroup()
+	group.SetName(plan.Name.ValueString())
+	group.SetDescription(plan.Description.ValueString())
+
+	networkIds, err := convert.SetToInt64Slice(plan.NetworkIds)
+	if err != nil {
+		return nil, err
+	}
+	group.SetNetworks(networkIds)
+
+	subnetIds, err := convert.SetToInt64Slice(plan.SubnetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets := []map[string]any{}
+	for _, id := range subnetIds {
+		subnets = append(subnets, map[string]any{"id": id})
+	}
+	group.SetSubnets(subnets)
+
+	// these aren't modelled by the sdk request type
+	group.AdditionalProperties = map[string]any{
+		"visibility": plan.Visibility.ValueString(),
+	}
+
+	if !plan.ResourcePermissions.IsNull() && !plan.ResourcePermissions.IsUnknown() {
+		resourcePermissions := map[string]any{
+			"all": plan.ResourcePermissions.All.ValueBool(),
+		}
+
+		if !plan.ResourcePermissions.GroupIds.IsUnknown() {
+			var groupIds []int64
+			diags := plan.ResourcePermissions.GroupIds.ElementsAs(ctx, &groupIds, false)
+			if diags.HasError() {
+				return nil, fmt.Errorf("invalid group_ids")
+			}
+
+			sites := []map[string]any{}
+			for _, id := range groupIds {
+				sites = append(sites, map[string]any{"id": id})
+			}
+			resourcePermissions["sites"] = sites
+		}
+
+		group.AdditionalProperties["resourcePermissions"] = resourcePermissions
+	}
+
+	return group, nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan NetworkGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	group, err := newNetworkGroup(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network group resource",
+			"network group "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network group resource",
+			"network group "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addGroupReq := sdk.NewCreateNetworkGroupRequest()
+	addGroupReq.SetNetworkGroup(*group)
+
+	g, hresp, err := client.NetworksAPI.CreateNetworkGroup(ctx).
+		CreateNetworkGroupRequest(*addGroupReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create network group resource",
+			"network group "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if g.Id.Get() == nil {
+		resp.Diagnostics.AddError(
+			"create network group resource",
+			"network group "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := int64(*g.Id.Get())
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getNetworkGroupAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create network group resource",
+			fmt.Sprintf("network group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan NetworkGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	group, err := newNetworkGroup(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network group resource",
+			"network group "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network group resource",
+			"network group "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateGroupReq := sdk.NewCreateNetworkGroupRequest()
+	updateGroupReq.SetNetworkGroup(*group)
+
+	_, hresp, err := client.NetworksAPI.UpdateNetworkGroup(ctx, id).
+		CreateNetworkGroupRequest(*updateGroupReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update network group resource",
+			"network group "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getNetworkGroupAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update network group resource",
+			fmt.Sprintf("network group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NetworkGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read network group resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getNetworkGroupAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read network group resource",
+			fmt.Sprintf("network group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the network group was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NetworkGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete network group resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteNetworkGroup(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete network group resource",
+			fmt.Sprintf("network group %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import network group resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
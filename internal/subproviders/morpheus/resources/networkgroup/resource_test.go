@@ -0,0 +1,159 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl GroupName "Example group" Name "Example network group" Description "Networks shared by the example group" Visibility "private"
+
+package networkgroup_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusNetworkGroupExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"GroupName", name,
+		"Name", name,
+		"Description", "test network group",
+		"Visibility", "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_group.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_group.example",
+			"description",
+			"test network group",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_group.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_group.example",
+			"resource_permissions.all",
+			"false",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_network_group.example",
+			"resource_permissions.group_ids.0",
+			"hpe_morpheus_group.example",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_group.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkGroupUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_network_group" "test" {
+  name        = "` + name + `"
+  description = "before"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_group.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_group.test", "description", "before"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the group is updated in place and the description is cleared
+resource "hpe_morpheus_network_group" "test" {
+  name       = "` + name + `-renamed"
+  visibility = "public"
+
+  resource_permissions = {
+    all = true
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_group.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_group.test", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_group.test", "resource_permissions.all", "true"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_group.test", "description"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+resource "hpe_morpheus_network_pool" "example" {
+  name            = "Example pool"
+  dns_domain      = "example.internal"
+  dns_search_path = "example.internal"
+  dns_servers     = ["10.10.0.2"]
+
+  ip_ranges = [
+    {
+      start_address = "10.10.0.10"
+      end_address   = "10.10.0.99"
+    },
+    {
+      start_address = "10.10.0.150"
+      end_address   = "10.10.0.199"
+    },
+  ]
+}
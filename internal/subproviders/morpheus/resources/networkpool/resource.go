@@ -0,0 +1,464 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networkpool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_network_pool"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = NetworkPoolResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// an empty list is returned for unset dns servers
+func strSliceToList(
+	ctx context.Context,
+	items []string,
+) (types.List, diag.Diagnostics) {
+	if len(items) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, items)
+}
+
+// populate network pool resource model with current API values. The returned
+// bool is false if the network pool no longer exists.
+func getNetworkPoolAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (NetworkPoolModel, bool, diag.Diagnostics) {
+	var state NetworkPoolModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.NetworksAPI.GetNetworkPool(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate network pool resource",
+			fmt.Sprintf("network pool %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	pool := p.GetNetworkPool()
+
+	state.Id = convert.Int64ToType(pool.Id)
+	state.Name = convert.StrToType(pool.Name)
+	state.TypeCode = convert.StrToType(pool.GetType().Code)
+	state.IpCount = convert.Int64ToType(pool.IpCount)
+	state.FreeCount = convert.Int64ToType(pool.FreeCount)
+	state.DnsDomain = convert.StrToTypeEmptyNull(pool.DnsDomain.Get())
+	state.DnsSearchPath = convert.StrToTypeEmptyNull(pool.DnsSearchPath.Get())
+
+	dnsServers, d := strSliceToList(ctx, pool.DnsServers)
+	diags.Append(d...)
+	state.DnsServers = dnsServers
+
+	dnsSuffixList, d := strSliceToList(ctx, pool.DnsSuffixList)
+	diags.Append(d...)
+	state.DnsSuffixList = dnsSuffixList
+
+	var ranges []IpRangesValue
+	for _, r := range pool.IpRanges {
+		ranges = append(ranges, IpRangesValue{
+			StartAddress: types.StringValue(r.GetStartAddress()),
+			EndAddress:   types.StringValue(r.GetEndAddress()),
+			state:        attr.ValueStateKnown,
+		})
+	}
+
+	ipRanges, d := types.SetValueFrom(ctx, IpRangesValue{}.Type(ctx), ranges)
+	diags.Append(d...)
+	state.IpRanges = ipRanges
+
+	return state, true, diags
+}
+
+// rangeKey identifies an ip range by its addresses
+func rangeKey(start string, end string) string {
+	return start + "-" + end
+}
+
+// newNetworkPool builds the request body shared by POST and PUT. The ids of
+// existing ranges, keyed by rangeKey, are included so that unchanged ranges
+// are kept rather than recreated.
+func newNetworkPool(
+	ctx context.Context,
+	plan NetworkPoolModel,
+	rangeIds map[string]int64,
+) (*sdk.CreateNetworkPoolRequestNetworkPool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pool := sdk.NewCreateNetworkPoolRequestNetworkPool()
+	pool.SetName(plan.Name.ValueString())
+
+	poolType := sdk.NewAddInstance200ResponseAllOfOneOfInstanceConfigInstanceType()
+	poolType.SetCode(plan.TypeCode.ValueString())
+	pool.SetType(*poolType)
+
+	var planRanges []IpRangesValue
+	diags.Append(plan.IpRanges.ElementsAs(ctx, &planRanges, false)...)
+
+	ranges := []sdk.CreateNetworkPoolRequestNetworkPoolIpRangesInner{}
+	for _, pr := range planRanges {
+		start := pr.StartAddress.ValueString()
+		end := pr.EndAddress.ValueString()
+
+		ipRange := sdk.NewCreateNetworkPoolRequestNetworkPoolIpRangesInner()
+		ipRange.SetStartAddress(start)
+		ipRange.SetEndAddress(end)
+
+		if id, ok := rangeIds[rangeKey(start, end)]; ok {
+			ipRange.AdditionalProperties = map[string]any{"id": id}
+		}
+
+		ranges = append(ranges, *ipRange)
+	}
+	pool.SetIpRanges(ranges)
+
+	// the dns settings aren't modelled by the sdk request types, empty
+	// values are sent to clear them
+	dnsServers := []string{}
+	if !plan.DnsServers.IsNull() {
+		diags.Append(plan.DnsServers.ElementsAs(ctx, &dnsServers, false)...)
+	}
+
+	dnsSuffixList := []string{}
+	if !plan.DnsSuffixList.IsNull() {
+		diags.Append(plan.DnsSuffixList.ElementsAs(ctx, &dnsSuffixList, false)...)
+	}
+
+	pool.AdditionalProperties = map[string]any{
+		"dnsDomain":     plan.DnsDomain.ValueString(),
+		"dnsSearchPath": plan.DnsSearchPath.ValueString(),
+		"dnsServers":    dnsServers,
+		"dnsSuffixList": dnsSuffixList,
+	}
+
+	return pool, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan NetworkPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	pool, diags := newNetworkPool(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network pool resource",
+			"network pool "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addPoolReq := sdk.NewCreateNetworkPoolRequest()
+	addPoolReq.SetNetworkPool(*pool)
+
+	p, hresp, err := client.NetworksAPI.CreateNetworkPool(ctx).
+		CreateNetworkPoolRequest(*addPoolReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create network pool resource",
+			"network pool "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.GetNetworkPool().Id == nil {
+		resp.Diagnostics.AddError(
+			"create network pool resource",
+			"network pool "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *p.GetNetworkPool().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getNetworkPoolAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create network pool resource",
+			fmt.Sprintf("network pool %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan NetworkPoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network pool resource",
+			"network pool "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	// ranges omitted from the PUT are removed, so look up the ids of the
+	// existing ranges to keep those that are unchanged
+	existing, hresp, err := client.NetworksAPI.GetNetworkPool(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update network pool resource",
+			"network pool "+name+" GET failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	rangeIds := map[string]int64{}
+	for _, r := range existing.GetNetworkPool().IpRanges {
+		if r.Id != nil {
+			rangeIds[rangeKey(r.GetStartAddress(), r.GetEndAddress())] = *r.Id
+		}
+	}
+
+	pool, diags := newNetworkPool(ctx, plan, rangeIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatePoolReq := sdk.NewCreateNetworkPoolRequest()
+	updatePoolReq.SetNetworkPool(*pool)
+
+	_, hresp, err = client.NetworksAPI.UpdateNetworkPool(ctx, id).
+		CreateNetworkPoolRequest(*updatePoolReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update network pool resource",
+			"network pool "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getNetworkPoolAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update network pool resource",
+			fmt.Sprintf("network pool %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NetworkPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read network pool resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getNetworkPoolAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read network pool resource",
+			fmt.Sprintf("network pool %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the network pool was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NetworkPoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete network pool resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteNetworkPool(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete network pool resource",
+			fmt.Sprintf("network pool %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import network pool resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,207 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example pool" DnsDomain "example.internal" DnsServer "10.10.0.2" RangeOneStart "10.10.0.10" RangeOneEnd "10.10.0.99" RangeTwoStart "10.10.0.150" RangeTwoEnd "10.10.0.199"
+
+package networkpool_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusNetworkPoolExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"DnsDomain", "example.internal",
+		"DnsServer", "10.10.0.2",
+		"RangeOneStart", "10.10.0.10",
+		"RangeOneEnd", "10.10.0.99",
+		"RangeTwoStart", "10.10.0.150",
+		"RangeTwoEnd", "10.10.0.199")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"type_code",
+			"morpheus",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"dns_domain",
+			"example.internal",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"dns_servers.0",
+			"10.10.0.2",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"ip_ranges.#",
+			"2",
+		),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"hpe_morpheus_network_pool.example",
+			"ip_ranges.*",
+			map[string]string{
+				"start_address": "10.10.0.150",
+				"end_address":   "10.10.0.199",
+			},
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool.example",
+			"ip_count",
+			"140",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_pool.example",
+				// free_count may change as addresses are allocated
+				ImportStateVerifyIgnore: []string{"free_count"},
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkPoolUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_network_pool" "test" {
+  name        = "` + name + `"
+  dns_domain  = "before.internal"
+  dns_servers = ["10.20.0.2", "10.20.0.3"]
+  ip_ranges = [
+    { start_address = "10.20.0.10", end_address = "10.20.0.19" },
+  ]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_pool.test", "ip_count", "10"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_pool.test", "dns_servers.#", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks a range is added in place and the dns settings are cleared
+resource "hpe_morpheus_network_pool" "test" {
+  name = "` + name + `-changed"
+  ip_ranges = [
+    { start_address = "10.20.0.10", end_address = "10.20.0.19" },
+    { start_address = "10.20.0.30", end_address = "10.20.0.39" },
+  ]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_pool.test", "name", name+"-changed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_pool.test", "ip_count", "20"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_pool.test", "dns_domain"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_pool.test", "dns_servers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkPoolOverlapFails(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_network_pool" "test" {
+  name = "overlap"
+  ip_ranges = [
+    { start_address = "10.30.0.10", end_address = "10.30.0.50" },
+    { start_address = "10.30.0.40", end_address = "10.30.0.60" },
+  ]
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Overlapping ip ranges"),
+			},
+		},
+	})
+}
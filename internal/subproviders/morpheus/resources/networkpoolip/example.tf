@@ -0,0 +1,24 @@
+resource "hpe_morpheus_network_pool" "example" {
+  name = "Example pool"
+
+  ip_ranges = [
+    {
+      start_address = "10.10.0.10"
+      end_address   = "10.10.0.99"
+    },
+  ]
+}
+
+# Allocate the next free address from the pool
+resource "hpe_morpheus_network_pool_ip" "example" {
+  network_pool_id = hpe_morpheus_network_pool.example.id
+  hostname        = "vip"
+  description     = "Load balancer VIP"
+}
+
+# Reserve a specific address from the pool
+resource "hpe_morpheus_network_pool_ip" "static" {
+  network_pool_id = hpe_morpheus_network_pool.example.id
+  hostname        = "appliance"
+  ip_address      = "10.10.0.50"
+}
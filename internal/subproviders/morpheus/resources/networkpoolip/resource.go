@@ -0,0 +1,411 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networkpoolip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+// An address is only addressable within its pool, so unlike most resources
+// the identity includes the pool id as well as the address id
+type identityModel struct {
+	NetworkPoolId types.Int64 `tfsdk:"network_pool_id"`
+	Id            types.Int64 `tfsdk:"id"`
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_network_pool_ip"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = NetworkPoolIpResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"network_pool_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the network pool",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the resource",
+			},
+		},
+	}
+}
+
+// The sdk models the address in the POST response as "networkPool" and not
+// at all in the GET response, whereas the api returns it as "networkPoolIp",
+// which ends up in the additional properties
+func poolIpFromResponse(
+	networkPool *sdk.CreateNetworkPoolIp200ResponseNetworkPool,
+	additional map[string]any,
+) (*sdk.CreateNetworkPoolIp200ResponseNetworkPool, error) {
+	var raw any
+	if ip, ok := additional["networkPoolIp"]; ok {
+		raw = ip
+	} else if networkPool != nil {
+		raw = networkPool
+	} else {
+		return nil, fmt.Errorf("network pool ip missing from response")
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var poolIp sdk.CreateNetworkPoolIp200ResponseNetworkPool
+	if err := json.Unmarshal(b, &poolIp); err != nil {
+		return nil, err
+	}
+
+	return &poolIp, nil
+}
+
+// populate network pool ip resource model with current API values. The
+// returned bool is false if the network pool ip no longer exists.
+func getNetworkPoolIpAsState(
+	ctx context.Context,
+	poolId int64,
+	id int64,
+	client *sdk.APIClient,
+) (NetworkPoolIpModel, bool, diag.Diagnostics) {
+	var state NetworkPoolIpModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.NetworksAPI.GetNetworkPoolIp(ctx, poolId, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate network pool ip resource",
+			fmt.Sprintf("network pool %d ip %d GET failed: ", poolId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	poolIp, err := poolIpFromResponse(nil, p.AdditionalProperties)
+	if err != nil {
+		diags.AddError(
+			"populate network pool ip resource",
+			fmt.Sprintf("network pool %d ip %d: ", poolId, id)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	state.Id = convert.Int64ToType(poolIp.Id)
+	state.NetworkPoolId = types.Int64Value(poolId)
+	state.IpAddress = convert.StrToType(poolIp.IpAddress)
+	state.Hostname = convert.StrToType(poolIp.Hostname)
+	state.Fqdn = convert.StrToType(poolIp.Fqdn)
+
+	state.Description = convert.StrToTypeEmptyNull(poolIp.Description.Get())
+
+	return state, true, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan NetworkPoolIpModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := plan.Hostname.ValueString()
+	poolId := plan.NetworkPoolId.ValueInt64()
+
+	addIp := sdk.NewCreateNetworkPoolIpRequestNetworkPoolIp()
+	addIp.SetHostname(hostname)
+
+	// the next free address is allocated when none is given
+	if !plan.IpAddress.IsUnknown() {
+		addIp.SetIpAddress(plan.IpAddress.ValueString())
+	}
+
+	// description isn't modelled by the sdk request type
+	if !plan.Description.IsNull() {
+		addIp.AdditionalProperties = map[string]any{
+			"description": plan.Description.ValueString(),
+		}
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network pool ip resource",
+			"network pool ip "+hostname+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addIpReq := sdk.NewCreateNetworkPoolIpRequest()
+	addIpReq.SetNetworkPoolIp(*addIp)
+
+	p, hresp, err := client.NetworksAPI.CreateNetworkPoolIp(ctx, poolId).
+		CreateNetworkPoolIpRequest(*addIpReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create network pool ip resource",
+			"network pool ip "+hostname+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	poolIp, err := poolIpFromResponse(p.NetworkPool, p.AdditionalProperties)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network pool ip resource",
+			"network pool ip "+hostname+": "+err.Error(),
+		)
+
+		return
+	}
+
+	if poolIp.Id == nil {
+		resp.Diagnostics.AddError(
+			"create network pool ip resource",
+			"network pool ip "+hostname+": id is nil",
+		)
+
+		return
+	}
+
+	id := *poolIp.Id
+	plan.Id = types.Int64Value(id)
+	plan.IpAddress = convert.StrToType(poolIp.IpAddress)
+	plan.Fqdn = convert.StrToType(poolIp.Fqdn)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			NetworkPoolId: plan.NetworkPoolId,
+			Id:            plan.Id,
+		})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getNetworkPoolIpAsState(ctx, poolId, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create network pool ip resource",
+			fmt.Sprintf("network pool %d ip %d: failed to read from api", poolId, id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes as all configurable attributes
+// force replacement, there being no api to update an address
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan NetworkPoolIpModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NetworkPoolIpModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read network pool ip resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	poolId := data.NetworkPoolId.ValueInt64()
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getNetworkPoolIpAsState(ctx, poolId, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read network pool ip resource",
+			fmt.Sprintf("network pool %d ip %d: failed to read from api", poolId, id),
+		)
+
+		return
+	}
+
+	// the network pool ip was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			NetworkPoolId: state.NetworkPoolId,
+			Id:            state.Id,
+		})...,
+	)
+}
+
+// Delete releases the address back to the pool
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NetworkPoolIpModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolId := data.NetworkPoolId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete network pool ip resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteNetworkPoolIp(ctx, poolId, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete network pool ip resource",
+			fmt.Sprintf("network pool %d ip %d: DELETE failed ", poolId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+// ImportState accepts an id of the form <network_pool_id>/<id>
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		var m identityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("network_pool_id"), m.NetworkPoolId)...,
+		)
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), m.Id)...,
+		)
+
+		return
+	}
+
+	poolIdStr, idStr, found := strings.Cut(req.ID, "/")
+	poolId, poolErr := strconv.ParseInt(poolIdStr, 10, 64)
+	id, idErr := strconv.ParseInt(idStr, 10, 64)
+	if !found || poolErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"import network pool ip resource",
+			"provided import ID '"+req.ID+"' is invalid (expected <network_pool_id>/<id>)",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("network_pool_id"), poolId)...,
+	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), id)...,
+	)
+}
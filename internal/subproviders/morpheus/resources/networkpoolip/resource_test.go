@@ -0,0 +1,131 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl PoolName "Example pool" RangeStart "10.10.0.10" RangeEnd "10.10.0.99" Hostname "vip" Description "Load balancer VIP" StaticHostname "appliance" StaticAddress "10.10.0.50"
+
+package networkpoolip_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// import ids are of the form <network_pool_id>/<id>
+func importStateId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+
+		return rs.Primary.Attributes["network_pool_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusNetworkPoolIpExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"PoolName", name,
+		"RangeStart", "10.40.0.10",
+		"RangeEnd", "10.40.0.99",
+		"Hostname", "vip",
+		"Description", "Load balancer VIP",
+		"StaticHostname", "appliance",
+		"StaticAddress", "10.40.0.50")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_network_pool_ip.example",
+			"network_pool_id",
+			"hpe_morpheus_network_pool.example",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool_ip.example",
+			"hostname",
+			"vip",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool_ip.example",
+			"description",
+			"Load balancer VIP",
+		),
+		resource.TestCheckResourceAttrSet(
+			"hpe_morpheus_network_pool_ip.example",
+			"ip_address",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_pool_ip.static",
+			"ip_address",
+			"10.40.0.50",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_pool_ip.example",
+				ImportStateIdFunc: importStateId("hpe_morpheus_network_pool_ip.example"),
+				Check:             checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_pool_ip.static",
+				ImportStateIdFunc: importStateId("hpe_morpheus_network_pool_ip.static"),
+				Check:             checkFn,
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package networkpoolip
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestPoolIpFromResponse(t *testing.T) {
+	t.Parallel()
+
+	body := `{
+		"success": true,
+		"networkPoolIp": {
+			"id": 12,
+			"networkPoolId": 3,
+			"ipAddress": "10.10.0.10",
+			"hostname": "vip",
+			"fqdn": "vip.example.internal",
+			"description": "load balancer vip"
+		}
+	}`
+
+	var resp sdk.CreateNetworkPoolIp200Response
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	poolIp, err := poolIpFromResponse(resp.NetworkPool, resp.AdditionalProperties)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), poolIp.GetId())
+	assert.Equal(t, "10.10.0.10", poolIp.GetIpAddress())
+	assert.Equal(t, "vip.example.internal", poolIp.GetFqdn())
+	assert.Equal(t, "load balancer vip", poolIp.GetDescription())
+}
+
+func TestPoolIpFromGetResponse(t *testing.T) {
+	t.Parallel()
+
+	body := `{"networkPoolIp": {"id": 12, "ipAddress": "10.10.0.10"}}`
+
+	var resp sdk.GetNetworkPoolIps200Response
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	poolIp, err := poolIpFromResponse(nil, resp.AdditionalProperties)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), poolIp.GetId())
+	assert.Equal(t, "10.10.0.10", poolIp.GetIpAddress())
+}
+
+func TestPoolIpFromResponseMissing(t *testing.T) {
+	t.Parallel()
+
+	var resp sdk.GetNetworkPoolIps200Response
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &resp))
+
+	_, err := poolIpFromResponse(nil, resp.AdditionalProperties)
+	assert.Error(t, err)
+}
+
+func TestGetNetworkPoolIpAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getNetworkPoolIpAsState(context.Background(), 2, 3, client)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
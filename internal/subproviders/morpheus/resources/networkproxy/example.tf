@@ -0,0 +1,11 @@
+resource "hpe_morpheus_network_proxy" "example" {
+  name       = "Corporate proxy"
+  proxy_host = "proxy.example.com"
+  proxy_port = 3128
+  proxy_user = "svc-proxy"
+  no_proxy   = "localhost,127.0.0.1,.example.internal"
+  visibility = "private"
+
+  proxy_password_wo         = "Secret123!"
+  proxy_password_wo_version = 1
+}
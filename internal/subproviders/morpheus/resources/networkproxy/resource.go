@@ -0,0 +1,391 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package networkproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_network_proxy"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = NetworkProxyResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate network proxy resource model with current API values. The
+// returned bool is false if the network proxy no longer exists.
+func getNetworkProxyAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (NetworkProxyModel, bool, diag.Diagnostics) {
+	var state NetworkProxyModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.NetworksAPI.GetNetworkProxy(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate network proxy resource",
+			fmt.Sprintf("network proxy %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	proxy := p.GetNetworkProxy()
+
+	state.Id = convert.Int64ToType(proxy.Id)
+	state.Name = convert.StrToType(proxy.Name)
+	state.ProxyHost = convert.StrToType(proxy.ProxyHost)
+	state.ProxyPort = convert.Int64ToType(proxy.ProxyPort)
+	state.ProxyUser = convert.StrToTypeEmptyNull(proxy.ProxyUser.Get())
+	state.ProxyDomain = convert.StrToTypeEmptyNull(proxy.ProxyDomain)
+	state.ProxyWorkstation = convert.StrToTypeEmptyNull(proxy.ProxyWorkstation.Get())
+	state.Visibility = convert.StrToType(proxy.Visibility)
+
+	// the exclusions aren't modelled by the sdk response type
+	noProxy, _ := proxy.AdditionalProperties["noProxy"].(string)
+	state.NoProxy = convert.StrToTypeEmptyNull(&noProxy)
+
+	return state, true, diags
+}
+
+// newNetworkProxy builds the request body shared by POST and PUT. Empty
+// strings are sent for unset attributes to clear them on update.
+func newNetworkProxy(plan NetworkProxyModel) *sdk.CreateNetworkProxyRequestNetworkProxy {
+	proxy := sdk.NewCreateNetworkProxyRequestNetworkProxy()
+	proxy.SetName(plan.Name.ValueString())
+	proxy.SetProxyHost(plan.ProxyHost.ValueString())
+	proxy.SetProxyPort(strconv.FormatInt(plan.ProxyPort.ValueInt64(), 10))
+	proxy.SetProxyUser(plan.ProxyUser.ValueString())
+	proxy.SetProxyDomain(plan.ProxyDomain.ValueString())
+	proxy.SetProxyWorkstation(plan.ProxyWorkstation.ValueString())
+	proxy.SetVisibility(plan.Visibility.ValueString())
+
+	// the exclusions aren't modelled by the sdk request type
+	proxy.AdditionalProperties = map[string]any{
+		"noProxy": plan.NoProxy.ValueString(),
+	}
+
+	return proxy
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan, config NetworkProxyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	proxy := newNetworkProxy(plan)
+
+	if !config.ProxyPasswordWo.IsNull() {
+		proxy.SetProxyPassword(config.ProxyPasswordWo.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create network proxy resource",
+			"network proxy "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addProxyReq := sdk.NewCreateNetworkProxyRequest()
+	addProxyReq.SetNetworkProxy(*proxy)
+
+	p, hresp, err := client.NetworksAPI.CreateNetworkProxy(ctx).
+		CreateNetworkProxyRequest(*addProxyReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create network proxy resource",
+			"network proxy "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.GetNetworkProxy().Id == nil {
+		resp.Diagnostics.AddError(
+			"create network proxy resource",
+			"network proxy "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *p.GetNetworkProxy().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getNetworkProxyAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create network proxy resource",
+			fmt.Sprintf("network proxy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	state.ProxyPasswordWoVersion = plan.ProxyPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state, config NetworkProxyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	proxy := newNetworkProxy(plan)
+
+	if !plan.ProxyPasswordWoVersion.Equal(state.ProxyPasswordWoVersion) {
+		if config.ProxyPasswordWo.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"update network proxy resource",
+				fmt.Sprintf("network proxy %s: 'proxy_password_wo_version' changed, "+
+					"but 'proxy_password_wo' is not set", name),
+			)
+
+			return
+		}
+		// an empty password clears it
+		proxy.SetProxyPassword(config.ProxyPasswordWo.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update network proxy resource",
+			"network proxy "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateProxyReq := sdk.NewCreateNetworkProxyRequest()
+	updateProxyReq.SetNetworkProxy(*proxy)
+
+	_, hresp, err := client.NetworksAPI.UpdateNetworkProxy(ctx, id).
+		CreateNetworkProxyRequest(*updateProxyReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update network proxy resource",
+			"network proxy "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	newState, found, pdiags := getNetworkProxyAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update network proxy resource",
+			fmt.Sprintf("network proxy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	newState.ProxyPasswordWoVersion = plan.ProxyPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: newState.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NetworkProxyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read network proxy resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getNetworkProxyAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read network proxy resource",
+			fmt.Sprintf("network proxy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the network proxy was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.ProxyPasswordWoVersion = data.ProxyPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NetworkProxyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete network proxy resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteNetworkProxy(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete network proxy resource",
+			fmt.Sprintf("network proxy %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import network proxy resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,172 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Corporate proxy" ProxyHost "proxy.example.com" ProxyPort 3128 ProxyUser "svc-proxy" NoProxy "localhost,127.0.0.1,.example.internal" Visibility "private"
+
+package networkproxy_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusNetworkProxyExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"ProxyHost", "proxy.example.com",
+		"ProxyPort", "3128",
+		"ProxyUser", "svc-proxy",
+		"NoProxy", "localhost,127.0.0.1",
+		"Visibility", "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"proxy_host",
+			"proxy.example.com",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"proxy_port",
+			"3128",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"proxy_user",
+			"svc-proxy",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"no_proxy",
+			"localhost,127.0.0.1",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckNoResourceAttr(
+			"hpe_morpheus_network_proxy.example",
+			"proxy_password_wo",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_network_proxy.example",
+				// not returned by the api
+				ImportStateVerifyIgnore: []string{"proxy_password_wo_version"},
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkProxyUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_network_proxy" "test" {
+  name       = "` + name + `"
+  proxy_host = "proxy.example.com"
+  proxy_port = 3128
+  proxy_user = "svc-proxy"
+  no_proxy   = "localhost"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_proxy.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_proxy.test", "no_proxy", "localhost"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the proxy is updated in place and the user and
+# exclusions are cleared
+resource "hpe_morpheus_network_proxy" "test" {
+  name       = "` + name + `"
+  proxy_host = "proxy2.example.com"
+  proxy_port = 8080
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_proxy.test", "proxy_host", "proxy2.example.com"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_network_proxy.test", "proxy_port", "8080"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_proxy.test", "proxy_user"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_network_proxy.test", "no_proxy"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,11 @@
+resource "hpe_morpheus_option_list" "example" {
+  name        = "Example option list"
+  description = "Sizes offered in the self-service catalog"
+  type        = "manual"
+  labels      = ["self-service"]
+
+  initial_dataset = jsonencode([
+    { name = "Small", value = "small" },
+    { name = "Large", value = "large" },
+  ])
+}
@@ -0,0 +1,70 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package optionlist
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetOptionListAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"optionTypeList": {
+		"id": 3,
+		"name": "test",
+		"description": "",
+		"labels": ["a"],
+		"type": "rest",
+		"sourceUrl": "https://example.com",
+		"sourceMethod": "GET",
+		"apiType": null,
+		"ignoreSSLErrors": true,
+		"realTime": false,
+		"visibility": "private",
+		"initialDataset": null,
+		"translationScript": "results = data;",
+		"requestScript": "",
+		"account": {"id": 1, "name": "root"}
+	}}`
+
+	client := testclient.NewJSON(t, body)
+
+	state, found, diags := getOptionListAsState(context.Background(), 3, client)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, int64(3), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "rest", state.Type.ValueString())
+	assert.Equal(t, "https://example.com", state.SourceUrl.ValueString())
+	assert.Equal(t, "GET", state.SourceMethod.ValueString())
+	assert.True(t, state.IgnoreSslErrors.ValueBool())
+	assert.False(t, state.RealTime.ValueBool())
+	assert.Equal(t, "results = data;", state.TranslationScript.ValueString())
+	// unset strings are returned as null or empty strings
+	assert.True(t, state.Description.IsNull())
+	assert.True(t, state.ApiType.IsNull())
+	assert.True(t, state.InitialDataset.IsNull())
+	assert.True(t, state.RequestScript.IsNull())
+}
+
+func TestGetOptionListAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getOptionListAsState(
+		context.Background(), 3, client,
+	)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
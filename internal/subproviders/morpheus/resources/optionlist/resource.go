@@ -0,0 +1,508 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package optionlist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithIdentity       = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+const (
+	typeManual = "manual"
+	typeRest   = "rest"
+	typeApi    = "api"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_option_list"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = OptionListResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// ValidateConfig checks the source of each option list type is set, as
+// schema validators can't depend on the value of another attribute.
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config OptionListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source types.String
+	var attribute string
+
+	switch config.Type.ValueString() {
+	case typeManual:
+		source, attribute = config.InitialDataset, "initial_dataset"
+	case typeRest:
+		source, attribute = config.SourceUrl, "source_url"
+	case typeApi:
+		source, attribute = config.ApiType, "api_type"
+	default:
+		return
+	}
+
+	if source.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Missing attribute in configuration",
+			fmt.Sprintf("%s is required for type %q.",
+				attribute, config.Type.ValueString()),
+		)
+	}
+}
+
+// optionList is the option list returned by the api. The sdk models the
+// get response as a list of option lists and the create response without
+// the option list, so the option list is decoded from the response body.
+type optionList struct {
+	Id                *int64   `json:"id"`
+	Name              *string  `json:"name"`
+	Description       *string  `json:"description"`
+	Labels            []string `json:"labels"`
+	Type              *string  `json:"type"`
+	Visibility        *string  `json:"visibility"`
+	SourceUrl         *string  `json:"sourceUrl"`
+	SourceMethod      *string  `json:"sourceMethod"`
+	ApiType           *string  `json:"apiType"`
+	IgnoreSSLErrors   *bool    `json:"ignoreSSLErrors"`
+	RealTime          *bool    `json:"realTime"`
+	InitialDataset    *string  `json:"initialDataset"`
+	TranslationScript *string  `json:"translationScript"`
+	RequestScript     *string  `json:"requestScript"`
+}
+
+// decodeOptionList decodes the option list from the body of an option list
+// response, which the sdk leaves readable after decoding
+func decodeOptionList(hresp *http.Response) (optionList, error) {
+	var body struct {
+		OptionTypeList *optionList `json:"optionTypeList"`
+	}
+
+	if err := json.NewDecoder(hresp.Body).Decode(&body); err != nil {
+		return optionList{}, err
+	}
+
+	if body.OptionTypeList == nil {
+		return optionList{}, fmt.Errorf("option list missing from response")
+	}
+
+	return *body.OptionTypeList, nil
+}
+
+// populate option list resource model with current API values. The returned
+// bool is false if the option list no longer exists.
+func getOptionListAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (OptionListModel, bool, diag.Diagnostics) {
+	var state OptionListModel
+	var diags diag.Diagnostics
+
+	_, hresp, err := client.LibraryAPI.GetOptionList(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate option list resource",
+			fmt.Sprintf("option list %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	l, err := decodeOptionList(hresp)
+	if err != nil {
+		diags.AddError(
+			"populate option list resource",
+			fmt.Sprintf("option list %d: failed to decode response: ", id)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	state.Id = convert.Int64ToType(l.Id)
+	state.Name = convert.StrToType(l.Name)
+	state.Description = convert.StrToTypeEmptyNull(l.Description)
+	state.Labels = convert.StrSliceToSet(l.Labels)
+	state.Type = convert.StrToType(l.Type)
+	state.Visibility = convert.StrToType(l.Visibility)
+	state.SourceUrl = convert.StrToTypeEmptyNull(l.SourceUrl)
+	state.SourceMethod = convert.StrToType(l.SourceMethod)
+	state.ApiType = convert.StrToTypeEmptyNull(l.ApiType)
+	state.IgnoreSslErrors = convert.BoolToType(l.IgnoreSSLErrors)
+	state.RealTime = convert.BoolToType(l.RealTime)
+	state.InitialDataset = convert.StrToTypeEmptyNull(l.InitialDataset)
+	state.TranslationScript = convert.StrToTypeEmptyNull(l.TranslationScript)
+	state.RequestScript = convert.StrToTypeEmptyNull(l.RequestScript)
+
+	return state, true, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan OptionListModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			"option list "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	l := sdk.NewAddOptionListRequestOptionTypeList(name)
+	l.SetType(plan.Type.ValueString())
+	l.SetVisibility(plan.Visibility.ValueString())
+	l.SetSourceMethod(plan.SourceMethod.ValueString())
+	l.SetIgnoreSSLErrors(plan.IgnoreSslErrors.ValueBool())
+	l.SetRealTime(plan.RealTime.ValueBool())
+	if labels != nil {
+		l.SetLabels(labels)
+	}
+	if !plan.Description.IsNull() {
+		l.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.SourceUrl.IsNull() {
+		l.SetSourceUrl(plan.SourceUrl.ValueString())
+	}
+	if !plan.ApiType.IsNull() {
+		l.SetApiType(plan.ApiType.ValueString())
+	}
+	if !plan.InitialDataset.IsNull() {
+		l.SetInitialDataset(plan.InitialDataset.ValueString())
+	}
+	if !plan.TranslationScript.IsNull() {
+		l.SetTranslationScript(plan.TranslationScript.ValueString())
+	}
+	if !plan.RequestScript.IsNull() {
+		l.SetRequestScript(plan.RequestScript.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			"option list "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addOptionList := sdk.NewAddOptionListRequest()
+	addOptionList.SetOptionTypeList(*l)
+
+	_, hresp, err := client.LibraryAPI.AddOptionList(ctx).
+		AddOptionListRequest(*addOptionList).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			"option list "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	created, err := decodeOptionList(hresp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			"option list "+name+": failed to decode response: "+err.Error(),
+		)
+
+		return
+	}
+
+	if created.Id == nil {
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			"option list "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *created.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getOptionListAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create option list resource",
+			fmt.Sprintf("option list %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan OptionListModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update option list resource",
+			"option list "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	l := sdk.NewUpdateOptionListRequestOptionTypeList()
+	l.SetName(name)
+	l.SetType(plan.Type.ValueString())
+	l.SetVisibility(plan.Visibility.ValueString())
+	l.SetSourceMethod(plan.SourceMethod.ValueString())
+	l.SetIgnoreSSLErrors(plan.IgnoreSslErrors.ValueBool())
+	l.SetRealTime(plan.RealTime.ValueBool())
+	// empty values clear the settings
+	l.Labels = append([]string{}, labels...)
+	l.SetSourceUrl(plan.SourceUrl.ValueString())
+	l.Description.Set(plan.Description.ValueStringPointer())
+	l.ApiType.Set(plan.ApiType.ValueStringPointer())
+	l.InitialDataset.Set(plan.InitialDataset.ValueStringPointer())
+	l.TranslationScript.Set(plan.TranslationScript.ValueStringPointer())
+	l.RequestScript.Set(plan.RequestScript.ValueStringPointer())
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update option list resource",
+			"option list "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateOptionList := sdk.NewUpdateOptionListRequest()
+	updateOptionList.SetOptionTypeList(*l)
+
+	_, hresp, err := client.LibraryAPI.UpdateOptionList(ctx, id).
+		UpdateOptionListRequest(*updateOptionList).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update option list resource",
+			"option list "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getOptionListAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update option list resource",
+			fmt.Sprintf("option list %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data OptionListModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read option list resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getOptionListAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read option list resource",
+			fmt.Sprintf("option list %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the option list was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data OptionListModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete option list resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.LibraryAPI.DeleteOptionList(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete option list resource",
+			fmt.Sprintf("option list %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import option list resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,193 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example option list"
+
+package optionlist_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusOptionListExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_list.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_list.example",
+			"type",
+			"manual",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_list.example",
+			"visibility",
+			"private",
+		),
+		resource.TestCheckResourceAttrSet(
+			"hpe_morpheus_option_list.example",
+			"initial_dataset",
+		),
+		resource.TestCheckTypeSetElemAttr(
+			"hpe_morpheus_option_list.example",
+			"labels.*",
+			"self-service",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_option_list.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusOptionListUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_option_list" "test" {
+  name               = "` + name + `"
+  type               = "rest"
+  source_url         = "https://example.com/api/sizes"
+  translation_script = "for (var x = 0; x < data.length; x++) { results.push({name: data[x].name, value: data[x].id}); }"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "source_method", "GET"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "real_time", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the option list is updated in place and the translation script is
+# cleared
+resource "hpe_morpheus_option_list" "test" {
+  name           = "` + name + `-renamed"
+  type           = "rest"
+  source_url     = "https://example.com/api/sizes/search"
+  source_method  = "POST"
+  request_script = "results = {max: 10};"
+  real_time      = true
+  visibility     = "public"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_option_list.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "source_method", "POST"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "real_time", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_list.test", "request_script", "results = {max: 10};"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_option_list.test", "translation_script"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusOptionListMissingSource(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_option_list" "test" {
+  name = "missing-source"
+  type = "api"
+}`,
+				ExpectError: regexp.MustCompile(`api_type is required for type "api"`),
+			},
+		},
+	})
+}
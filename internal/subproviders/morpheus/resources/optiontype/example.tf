@@ -0,0 +1,21 @@
+resource "hpe_morpheus_option_list" "example" {
+  name = "Example option type sizes"
+  type = "manual"
+
+  initial_dataset = jsonencode([
+    { name = "Small", value = "small" },
+    { name = "Large", value = "large" },
+  ])
+}
+
+resource "hpe_morpheus_option_type" "example" {
+  name           = "Example option type"
+  description    = "Size of the instance"
+  type           = "select"
+  field_name     = "size"
+  field_label    = "Size"
+  default_value  = "small"
+  help_block     = "Larger sizes cost more"
+  required       = true
+  option_list_id = hpe_morpheus_option_list.example.id
+}
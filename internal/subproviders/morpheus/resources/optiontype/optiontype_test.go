@@ -0,0 +1,104 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package optiontype
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetOptionTypeAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"optionType": {
+		"id": 8,
+		"name": "test",
+		"description": null,
+		"labels": ["a"],
+		"code": null,
+		"fieldName": "size",
+		"fieldLabel": "Size",
+		"fieldContext": "config",
+		"placeHolder": "",
+		"verifyPattern": null,
+		"helpBlock": "pick one",
+		"defaultValue": "small",
+		"optionSource": null,
+		"optionList": {"id": 3, "name": "sizes"},
+		"type": "select",
+		"advanced": false,
+		"required": true,
+		"exportMeta": false,
+		"editable": true,
+		"config": {},
+		"displayOrder": 0
+	}}`
+
+	client := testclient.NewJSON(t, body)
+
+	state, found, diags := getOptionTypeAsState(context.Background(), 8, client)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, int64(8), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "select", state.Type.ValueString())
+	assert.Equal(t, "size", state.FieldName.ValueString())
+	assert.Equal(t, "Size", state.FieldLabel.ValueString())
+	assert.Equal(t, "pick one", state.HelpBlock.ValueString())
+	assert.Equal(t, "small", state.DefaultValue.ValueString())
+	assert.Equal(t, int64(3), state.OptionListId.ValueInt64())
+	assert.True(t, state.Required.ValueBool())
+	assert.False(t, state.ExportMeta.ValueBool())
+	assert.True(t, state.Editable.ValueBool())
+	assert.True(t, state.Description.IsNull())
+	assert.True(t, state.Placeholder.IsNull())
+	assert.True(t, state.VerifyPattern.IsNull())
+}
+
+func TestDecodeId(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body    string
+		want    int64
+		wantErr bool
+	}{
+		"created": {
+			body: `{"success": true, "optionType": {"id": 8, "name": "test"}}`,
+			want: 8,
+		},
+		"missing": {
+			body:    `{"success": true}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := decodeId(&http.Response{
+				Body: io.NopCloser(strings.NewReader(tc.body)),
+			})
+			if tc.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, id)
+		})
+	}
+}
@@ -0,0 +1,440 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package optiontype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_option_type"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = OptionTypeResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// decodeId decodes the id of the created option type from the body of the
+// create response. The sdk models the response without the option type, but
+// leaves the body readable after decoding.
+func decodeId(hresp *http.Response) (int64, error) {
+	var body struct {
+		OptionType *struct {
+			Id *int64 `json:"id"`
+		} `json:"optionType"`
+	}
+
+	if err := json.NewDecoder(hresp.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+
+	if body.OptionType == nil || body.OptionType.Id == nil {
+		return 0, fmt.Errorf("option type id missing from response")
+	}
+
+	return *body.OptionType.Id, nil
+}
+
+// populate option type resource model with current API values. The returned
+// bool is false if the option type no longer exists.
+func getOptionTypeAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (OptionTypeModel, bool, diag.Diagnostics) {
+	var state OptionTypeModel
+	var diags diag.Diagnostics
+
+	o, hresp, err := client.LibraryAPI.GetInput(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate option type resource",
+			fmt.Sprintf("option type %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	optionType := o.GetOptionType()
+
+	state.Id = convert.Int64ToType(optionType.Id)
+	state.Name = convert.StrToType(optionType.Name)
+	state.Description = convert.StrToTypeEmptyNull(optionType.Description.Get())
+	state.Labels = convert.StrSliceToSet(optionType.Labels)
+	state.Type = convert.StrToType(optionType.Type)
+	state.FieldName = convert.StrToType(optionType.FieldName)
+	state.FieldLabel = convert.StrToType(optionType.FieldLabel)
+	state.Placeholder = convert.StrToTypeEmptyNull(optionType.PlaceHolder.Get())
+	state.VerifyPattern = convert.StrToTypeEmptyNull(optionType.VerifyPattern.Get())
+	state.HelpBlock = convert.StrToTypeEmptyNull(optionType.HelpBlock.Get())
+	state.DefaultValue = convert.StrToTypeEmptyNull(optionType.DefaultValue.Get())
+	state.Required = convert.BoolToType(optionType.Required)
+	state.ExportMeta = convert.BoolToType(optionType.ExportMeta)
+	state.Editable = convert.BoolToType(optionType.Editable)
+
+	state.OptionListId = types.Int64Null()
+	if optionType.OptionList != nil {
+		state.OptionListId = convert.Int64ToType(optionType.OptionList.Id)
+	}
+
+	return state, true, diags
+}
+
+// optionTypeProperties returns the option type settings the sdk doesn't
+// model. Null values clear the setting on update.
+func optionTypeProperties(plan OptionTypeModel) map[string]any {
+	return map[string]any{
+		"helpBlock": plan.HelpBlock.ValueStringPointer(),
+		"optionList": map[string]any{
+			"id": plan.OptionListId.ValueInt64Pointer(),
+		},
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan OptionTypeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option type resource",
+			"option type "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	optionType := sdk.NewAddOptionTypeRequestOptionType(name)
+	optionType.SetType(plan.Type.ValueString())
+	optionType.SetFieldName(plan.FieldName.ValueString())
+	optionType.SetFieldLabel(plan.FieldLabel.ValueString())
+	optionType.SetRequired(plan.Required.ValueBool())
+	optionType.SetExportMeta(plan.ExportMeta.ValueBool())
+	optionType.SetEditable(plan.Editable.ValueBool())
+	optionType.AdditionalProperties = optionTypeProperties(plan)
+	if labels != nil {
+		optionType.SetLabels(labels)
+	}
+	if !plan.Description.IsNull() {
+		optionType.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.Placeholder.IsNull() {
+		optionType.SetPlaceHolder(plan.Placeholder.ValueString())
+	}
+	if !plan.VerifyPattern.IsNull() {
+		optionType.SetVerifyPattern(plan.VerifyPattern.ValueString())
+	}
+	if !plan.DefaultValue.IsNull() {
+		optionType.SetDefaultValue(plan.DefaultValue.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option type resource",
+			"option type "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addOptionType := sdk.NewAddOptionTypeRequest()
+	addOptionType.SetOptionType(*optionType)
+
+	_, hresp, err := client.LibraryAPI.AddOptionType(ctx).
+		AddOptionTypeRequest(*addOptionType).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create option type resource",
+			"option type "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	id, err := decodeId(hresp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create option type resource",
+			"option type "+name+": failed to decode response: "+err.Error(),
+		)
+
+		return
+	}
+
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getOptionTypeAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create option type resource",
+			fmt.Sprintf("option type %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan OptionTypeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update option type resource",
+			"option type "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	optionType := sdk.NewUpdateOptionTypeRequestOptionType()
+	optionType.SetName(name)
+	optionType.SetType(plan.Type.ValueString())
+	optionType.SetFieldName(plan.FieldName.ValueString())
+	optionType.SetFieldLabel(plan.FieldLabel.ValueString())
+	optionType.SetRequired(plan.Required.ValueBool())
+	optionType.SetExportMeta(plan.ExportMeta.ValueBool())
+	optionType.SetEditable(plan.Editable.ValueBool())
+	// empty values clear the settings
+	optionType.Labels = append([]string{}, labels...)
+	optionType.Description.Set(plan.Description.ValueStringPointer())
+	optionType.SetPlaceHolder(plan.Placeholder.ValueString())
+	optionType.SetVerifyPattern(plan.VerifyPattern.ValueString())
+	optionType.SetDefaultValue(plan.DefaultValue.ValueString())
+	optionType.AdditionalProperties = optionTypeProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update option type resource",
+			"option type "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateOptionType := sdk.NewUpdateOptionTypeRequest()
+	updateOptionType.SetOptionType(*optionType)
+
+	_, hresp, err := client.LibraryAPI.UpdateOptionType(ctx, id).
+		UpdateOptionTypeRequest(*updateOptionType).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update option type resource",
+			"option type "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getOptionTypeAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update option type resource",
+			fmt.Sprintf("option type %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data OptionTypeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read option type resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getOptionTypeAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read option type resource",
+			fmt.Sprintf("option type %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the option type was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data OptionTypeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete option type resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.LibraryAPI.DeleteOptionType(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete option type resource",
+			fmt.Sprintf("option type %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import option type resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
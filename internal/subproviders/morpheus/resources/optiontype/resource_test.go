@@ -0,0 +1,177 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example option type"
+
+package optiontype_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusOptionTypeExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_type.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_type.example",
+			"type",
+			"select",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_type.example",
+			"field_name",
+			"size",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_type.example",
+			"help_block",
+			"Larger sizes cost more",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_option_type.example",
+			"required",
+			"true",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_option_type.example",
+			"option_list_id",
+			"hpe_morpheus_option_list.example",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_option_type.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusOptionTypeUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_option_type" "test" {
+  name           = "` + name + `"
+  type           = "text"
+  field_name     = "hostname"
+  field_label    = "Hostname"
+  placeholder    = "web01"
+  verify_pattern = "^[a-z0-9-]+$"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "placeholder", "web01"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "required", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the option type is updated in place and the placeholder and verify
+# pattern are cleared
+resource "hpe_morpheus_option_type" "test" {
+  name        = "` + name + `-renamed"
+  type        = "textarea"
+  field_name  = "hostname"
+  field_label = "Host name"
+  required    = true
+  editable    = true
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_option_type.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "type", "textarea"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "required", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_option_type.test", "editable", "true"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_option_type.test", "placeholder"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_option_type.test", "verify_pattern"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package policy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func namingConfig(pattern string) types.Dynamic {
+	return types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"namingPattern":  types.StringType,
+			"namingConflict": types.BoolType,
+		},
+		map[string]attr.Value{
+			"namingPattern":  types.StringValue(pattern),
+			"namingConflict": types.BoolValue(true),
+		},
+	))
+}
+
+func TestConfigToType(t *testing.T) {
+	t.Parallel()
+
+	maxVms := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"maxVms": types.NumberType},
+		map[string]attr.Value{"maxVms": types.NumberValue(big.NewFloat(10))},
+	))
+
+	cases := map[string]struct {
+		prior  types.Dynamic
+		config map[string]any
+		want   types.Dynamic
+	}{
+		"null prior": {
+			prior:  types.DynamicNull(),
+			config: map[string]any{"maxVms": "10"},
+			want:   types.DynamicNull(),
+		},
+		"defaults ignored": {
+			prior: namingConfig("vm-${sequence}"),
+			config: map[string]any{
+				"namingType":     "user",
+				"namingPattern":  "vm-${sequence}",
+				"namingConflict": true,
+			},
+			want: namingConfig("vm-${sequence}"),
+		},
+		"reshaped value": {
+			prior:  maxVms,
+			config: map[string]any{"maxVms": "10"},
+			want:   maxVms,
+		},
+		"changed value": {
+			prior: namingConfig("vm-${sequence}"),
+			config: map[string]any{
+				"namingPattern":  "host-${sequence}",
+				"namingConflict": true,
+			},
+			want: namingConfig("host-${sequence}"),
+		},
+		"removed key": {
+			prior:  namingConfig("vm-${sequence}"),
+			config: map[string]any{"namingConflict": true},
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"namingConflict": types.BoolType},
+				map[string]attr.Value{"namingConflict": types.BoolValue(true)},
+			)),
+		},
+		"import": {
+			prior: types.DynamicUnknown(),
+			config: map[string]any{
+				"namingPattern":  "vm-${sequence}",
+				"namingConflict": true,
+			},
+			want: namingConfig("vm-${sequence}"),
+		},
+		"import empty": {
+			prior:  types.DynamicUnknown(),
+			config: map[string]any{},
+			want:   types.DynamicNull(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := configToType(context.Background(), tc.prior, tc.config)
+			assert.True(t, tc.want.Equal(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
+
+func TestGetPolicyAsStateConfig(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.NewJSON(t, `{"policy": {"id": 1, "name": "test",
+		"enabled": true, "policyType": {"code": "naming"},
+		"config": {"namingType": "user", "namingPattern": "host-${sequence}",
+			"namingConflict": true}}}`)
+
+	state, found, diags := getPolicyAsState(
+		context.Background(), 1, namingConfig("vm-${sequence}"), client,
+	)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+	assert.True(t, namingConfig("host-${sequence}").Equal(state.Config), state.Config)
+}
@@ -0,0 +1,10 @@
+resource "hpe_morpheus_policy" "example" {
+  name        = "Example policy"
+  description = "Limit the number of VMs in the group"
+  type_code   = "maxVms"
+  group_id    = 1
+
+  config = {
+    maxVms = "10"
+  }
+}
@@ -0,0 +1,561 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// api reference types of the policy scopes, a policy without a reference is
+// global
+const (
+	refTypeCloud = "ComputeZone"
+	refTypeGroup = "ComputeSite"
+	refTypeRole  = "Role"
+	refTypeUser  = "User"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_policy"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = PolicyResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate policy resource model with current API values. The config is
+// compared against the prior config, see configToType. The returned bool is
+// false if the policy no longer exists.
+func getPolicyAsState(
+	ctx context.Context,
+	id int64,
+	prior types.Dynamic,
+	client *sdk.APIClient,
+) (PolicyModel, bool, diag.Diagnostics) {
+	var state PolicyModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.PoliciesAPI.GetPolicies(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate policy resource",
+			fmt.Sprintf("policy %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	policy := p.GetPolicy()
+
+	state.Id = convert.Int64ToType(policy.Id)
+	state.Name = convert.StrToType(policy.Name)
+	state.Description = convert.StrToTypeEmptyNull(policy.Description.Get())
+	state.TypeCode = convert.StrToType(policy.GetPolicyType().Code)
+	state.Enabled = convert.BoolToType(policy.Enabled)
+
+	// the sdk models the config as one of the known policy type
+	// configurations, so the config is read from the response body
+	var body struct {
+		Policy struct {
+			Config map[string]any `json:"config"`
+		} `json:"policy"`
+	}
+	if err := json.NewDecoder(hresp.Body).Decode(&body); err != nil {
+		diags.AddError(
+			"populate policy resource",
+			fmt.Sprintf("policy %d: failed to decode config: ", id)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	state.Config = configToType(ctx, prior, body.Policy.Config)
+
+	state.CloudId = types.Int64Null()
+	state.GroupId = types.Int64Null()
+	state.RoleId = types.Int64Null()
+	state.UserId = types.Int64Null()
+
+	switch {
+	case policy.Zone != nil:
+		state.CloudId = convert.Int64ToType(policy.Zone.Id)
+	case policy.Site != nil:
+		state.GroupId = convert.Int64ToType(policy.Site.Id)
+	case policy.Role != nil:
+		state.RoleId = convert.Int64ToType(policy.Role.Id)
+	case policy.User != nil:
+		state.UserId = convert.Int64ToType(policy.User.Id)
+	}
+
+	return state, true, diags
+}
+
+// configToType returns the config stored by the api. The api adds type
+// specific defaults, so only the keys set in the prior config are kept, and
+// the prior value of a key is kept when the api only reshapes it, eg a number
+// returned as a string. The full config is returned for an unknown prior
+// config, which is set on import.
+func configToType(
+	ctx context.Context,
+	prior types.Dynamic,
+	config map[string]any,
+) types.Dynamic {
+	if prior.IsNull() {
+		return prior
+	}
+
+	if prior.IsUnknown() {
+		if len(config) == 0 {
+			return types.DynamicNull()
+		}
+
+		return types.DynamicValue(anyToConfigValue(ctx, config))
+	}
+
+	var priorValues map[string]attr.Value
+	switch v := prior.UnderlyingValue().(type) {
+	case types.Object:
+		priorValues = v.Attributes()
+	case types.Map:
+		priorValues = v.Elements()
+	default:
+		return types.DynamicValue(anyToConfigValue(ctx, config))
+	}
+
+	drift := false
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for key, priorValue := range priorValues {
+		value, ok := config[key]
+		if !ok {
+			drift = true
+
+			continue
+		}
+
+		priorAny, err := convert.ValueToAny(ctx, priorValue)
+		if err == nil && fmt.Sprint(priorAny) == fmt.Sprint(value) {
+			attrTypes[key] = priorValue.Type(ctx)
+			attrs[key] = priorValue
+
+			continue
+		}
+
+		drift = true
+		attrs[key] = anyToConfigValue(ctx, value)
+		attrTypes[key] = attrs[key].Type(ctx)
+	}
+
+	if !drift {
+		return prior
+	}
+
+	return types.DynamicValue(types.ObjectValueMust(attrTypes, attrs))
+}
+
+// anyToConfigValue converts a decoded json config value to a framework value,
+// objects and arrays are converted to objects and tuples as their elements
+// may have different types
+func anyToConfigValue(ctx context.Context, a any) attr.Value {
+	switch v := a.(type) {
+	case string:
+		return types.StringValue(v)
+	case bool:
+		return types.BoolValue(v)
+	case float64:
+		return types.NumberValue(big.NewFloat(v))
+	case map[string]any:
+		attrTypes := map[string]attr.Type{}
+		attrs := map[string]attr.Value{}
+		for key, value := range v {
+			attrs[key] = anyToConfigValue(ctx, value)
+			attrTypes[key] = attrs[key].Type(ctx)
+		}
+
+		return types.ObjectValueMust(attrTypes, attrs)
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, value := range v {
+			elem := anyToConfigValue(ctx, value)
+			elemTypes = append(elemTypes, elem.Type(ctx))
+			elems = append(elems, elem)
+		}
+
+		return types.TupleValueMust(elemTypes, elems)
+	default:
+		return types.StringNull()
+	}
+}
+
+// scopeRef returns the api reference type and id of the scope set in the
+// plan, ok is false for a global policy
+func scopeRef(plan PolicyModel) (refType string, refId int64, ok bool) {
+	switch {
+	case !plan.CloudId.IsNull():
+		return refTypeCloud, plan.CloudId.ValueInt64(), true
+	case !plan.GroupId.IsNull():
+		return refTypeGroup, plan.GroupId.ValueInt64(), true
+	case !plan.RoleId.IsNull():
+		return refTypeRole, plan.RoleId.ValueInt64(), true
+	case !plan.UserId.IsNull():
+		return refTypeUser, plan.UserId.ValueInt64(), true
+	}
+
+	return "", 0, false
+}
+
+// newAddPolicy returns the create request for the plan. The sdk models the
+// config as one of the known policy type configurations, so the config is
+// sent as an additional property, which takes precedence over the typed
+// field.
+func newAddPolicy(
+	ctx context.Context,
+	plan PolicyModel,
+) (*sdk.AddPoliciesRequestPolicy, error) {
+	config, err := convert.DynamicToMap(ctx, plan.Config)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	policyType := sdk.NewAddPoliciesRequestPolicyPolicyType()
+	policyType.SetCode(plan.TypeCode.ValueString())
+
+	policy := sdk.NewAddPoliciesRequestPolicy(
+		plan.Name.ValueString(),
+		*policyType,
+		sdk.AddPoliciesRequestPolicyConfig{},
+	)
+	policy.SetEnabled(plan.Enabled.ValueBool())
+	if !plan.Description.IsNull() {
+		policy.SetDescription(plan.Description.ValueString())
+	}
+	if refType, refId, ok := scopeRef(plan); ok {
+		policy.SetRefType(refType)
+		policy.SetRefId(refId)
+	}
+
+	policy.AdditionalProperties = map[string]any{"config": config}
+
+	return policy, nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan PolicyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	policy, err := newAddPolicy(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create policy resource",
+			"policy "+name+": failed to convert config: "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create policy resource",
+			"policy "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	p, hresp, err := client.PoliciesAPI.AddPolicies(ctx).
+		AddPoliciesRequest(*sdk.NewAddPoliciesRequest(*policy)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create policy resource",
+			"policy "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.GetPolicy().Id == nil {
+		resp.Diagnostics.AddError(
+			"create policy resource",
+			"policy "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *p.GetPolicy().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getPolicyAsState(ctx, id, plan.Config, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create policy resource",
+			fmt.Sprintf("policy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan PolicyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	// a null config is sent as an empty object to clear the settings
+	config, err := convert.DynamicToMap(ctx, plan.Config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update policy resource",
+			fmt.Sprintf("policy %d: failed to convert config: ", id)+err.Error(),
+		)
+
+		return
+	}
+
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	policy := sdk.NewUpdatePoliciesRequestPolicy()
+	policy.SetName(plan.Name.ValueString())
+	policy.SetEnabled(plan.Enabled.ValueBool())
+	// an empty string clears the description
+	policy.SetDescription(plan.Description.ValueString())
+	policy.AdditionalProperties = map[string]any{"config": config}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update policy resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.PoliciesAPI.UpdatePolicies(ctx, id).
+		UpdatePoliciesRequest(*sdk.NewUpdatePoliciesRequest(*policy)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update policy resource",
+			fmt.Sprintf("policy %d PUT failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getPolicyAsState(ctx, id, plan.Config, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update policy resource",
+			fmt.Sprintf("policy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read policy resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getPolicyAsState(ctx, id, data.Config, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read policy resource",
+			fmt.Sprintf("policy %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the policy was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PolicyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete policy resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.PoliciesAPI.RemovePolicies(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete policy resource",
+			fmt.Sprintf("policy %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+	} else {
+		id, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"import policy resource",
+				"provided import ID '"+req.ID+"' is invalid (non-number)",
+			)
+
+			return
+		}
+
+		diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// there is no prior config to compare against, so read it in full
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("config"), types.DynamicUnknown())...,
+	)
+}
@@ -0,0 +1,180 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example policy" Description "Limit the number of VMs in the group" GroupId 1 MaxVms 10
+
+package policy_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusPolicyExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "test policy",
+		"GroupId", "1",
+		"MaxVms", "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"description",
+			"test policy",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"type_code",
+			"maxVms",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"group_id",
+			"1",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"enabled",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_policy.example",
+			"config.maxVms",
+			"10",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_policy.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusPolicyUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_policy" "test" {
+  name        = "` + name + `"
+  description = "before"
+  type_code   = "naming"
+
+  config = {
+    namingType     = "user"
+    namingPattern  = "vm-$${sequence}"
+    namingConflict = true
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_policy.test", "description", "before"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_policy.test", "group_id"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the policy is updated in place and the description is cleared
+resource "hpe_morpheus_policy" "test" {
+  name      = "` + name + `-renamed"
+  type_code = "naming"
+  enabled   = false
+
+  config = {
+    namingType     = "enforced"
+    namingPattern  = "app-$${sequence}"
+    namingConflict = false
+  }
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_policy.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_policy.test", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_policy.test", "config.namingType", "enforced"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_policy.test", "description"),
+				),
+			},
+		},
+	})
+}
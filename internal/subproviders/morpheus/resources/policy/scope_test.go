@@ -0,0 +1,149 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func newTestPlan() PolicyModel {
+	return PolicyModel{
+		Name:        types.StringValue("test"),
+		Description: types.StringNull(),
+		TypeCode:    types.StringValue("maxVms"),
+		Enabled:     types.BoolValue(true),
+		Config: types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"maxVms": types.StringType},
+			map[string]attr.Value{"maxVms": types.StringValue("10")},
+		)),
+		CloudId: types.Int64Null(),
+		GroupId: types.Int64Null(),
+		RoleId:  types.Int64Null(),
+		UserId:  types.Int64Null(),
+	}
+}
+
+func TestNewAddPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		scope func(*PolicyModel)
+		want  string
+	}{
+		"global": {
+			scope: func(*PolicyModel) {},
+			want: `{
+				"name": "test",
+				"enabled": true,
+				"policyType": {"code": "maxVms"},
+				"config": {"maxVms": "10"}
+			}`,
+		},
+		"group": {
+			scope: func(p *PolicyModel) { p.GroupId = types.Int64Value(2) },
+			want: `{
+				"name": "test",
+				"enabled": true,
+				"policyType": {"code": "maxVms"},
+				"config": {"maxVms": "10"},
+				"refType": "ComputeSite",
+				"refId": 2
+			}`,
+		},
+		"user": {
+			scope: func(p *PolicyModel) { p.UserId = types.Int64Value(3) },
+			want: `{
+				"name": "test",
+				"enabled": true,
+				"policyType": {"code": "maxVms"},
+				"config": {"maxVms": "10"},
+				"refType": "User",
+				"refId": 3
+			}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := newTestPlan()
+			tc.scope(&plan)
+
+			policy, err := newAddPolicy(context.Background(), plan)
+			require.NoError(t, err)
+
+			body, err := json.Marshal(policy)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(body))
+		})
+	}
+}
+
+func TestGetPolicyAsStateScope(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body   string
+		verify func(*testing.T, PolicyModel)
+	}{
+		"global": {
+			body: `{"policy": {"id": 1, "name": "test", "enabled": true,
+				"policyType": {"code": "maxVms"}, "config": {"maxVms": "10"}}}`,
+			verify: func(t *testing.T, s PolicyModel) {
+				assert.True(t, s.CloudId.IsNull())
+				assert.True(t, s.GroupId.IsNull())
+				assert.True(t, s.RoleId.IsNull())
+				assert.True(t, s.UserId.IsNull())
+			},
+		},
+		"cloud": {
+			body: `{"policy": {"id": 1, "name": "test", "enabled": true,
+				"policyType": {"code": "maxVms"}, "config": {"maxVms": "10"},
+				"refType": "ComputeZone", "refId": 4,
+				"zone": {"id": 4, "name": "cloud"}}}`,
+			verify: func(t *testing.T, s PolicyModel) {
+				assert.Equal(t, int64(4), s.CloudId.ValueInt64())
+				assert.True(t, s.GroupId.IsNull())
+			},
+		},
+		"role": {
+			body: `{"policy": {"id": 1, "name": "test", "enabled": false,
+				"policyType": {"code": "naming"},
+				"config": {"namingType": "user", "namingPattern": "vm-${sequence}"},
+				"refType": "Role", "refId": 5,
+				"role": {"id": 5, "authority": "ops"}}}`,
+			verify: func(t *testing.T, s PolicyModel) {
+				assert.Equal(t, int64(5), s.RoleId.ValueInt64())
+				assert.False(t, s.Enabled.ValueBool())
+				assert.Equal(t, "naming", s.TypeCode.ValueString())
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testclient.NewJSON(t, tc.body)
+
+			state, found, diags := getPolicyAsState(context.Background(), 1, types.DynamicNull(), client)
+			require.False(t, diags.HasError(), diags)
+			require.True(t, found)
+			assert.Equal(t, int64(1), state.Id.ValueInt64())
+			assert.True(t, state.Config.IsNull())
+			tc.verify(t, state)
+		})
+	}
+}
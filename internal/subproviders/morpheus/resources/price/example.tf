@@ -0,0 +1,9 @@
+resource "hpe_morpheus_price" "example" {
+  name           = "Memory per GB"
+  code           = "memory-gb"
+  price_type     = "memory"
+  price_unit     = "hour"
+  cost           = 0.015
+  markup_type    = "percent"
+  markup_percent = 10
+}
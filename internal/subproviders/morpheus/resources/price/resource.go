@@ -0,0 +1,403 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package price
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_price"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = PriceResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate price resource model with current API values. The returned bool
+// is false if the price no longer exists or has been deactivated, prices
+// can't be deleted.
+func getPriceAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (PriceModel, bool, diag.Diagnostics) {
+	var state PriceModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.PricesAPI.GetPrices(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate price resource",
+			fmt.Sprintf("price %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	price := p.GetPrice()
+	if !price.GetActive() {
+		return state, false, diags
+	}
+
+	state.Id = convert.Int64ToType(price.Id)
+	state.Name = convert.StrToType(price.Name)
+	state.Code = convert.StrToType(price.Code)
+	state.PriceType = convert.StrToType(price.PriceType)
+	state.PriceUnit = convert.StrToType(price.PriceUnit)
+	state.IncurCharges = convert.StrToType(price.IncurCharges)
+	state.Currency = convert.StrToType(price.Currency)
+	state.Cost = convert.Float32ToType(price.Cost.Get())
+	state.Platform = convert.StrToTypeEmptyNull(price.Platform.Get())
+	state.Software = convert.StrToTypeEmptyNull(price.Software.Get())
+	state.MarkupType = convert.StrToTypeEmptyNull(price.MarkupType.Get())
+
+	// the api reports defaults for the markups that don't apply to the
+	// markup type, only the one in use is kept
+	state.Markup = types.Float64Null()
+	state.MarkupPercent = types.Float64Null()
+	state.CustomPrice = types.Float64Null()
+
+	switch state.MarkupType.ValueString() {
+	case "fixed":
+		state.Markup = convert.Float32ToType(price.Markup.Get())
+	case "percent":
+		state.MarkupPercent = convert.Float32ToType(price.MarkupPercent.Get())
+	case "custom":
+		state.CustomPrice = convert.Float32ToType(price.CustomPrice.Get())
+	}
+
+	return state, true, diags
+}
+
+func float64ToAny(f types.Float64) any {
+	if f.IsNull() {
+		return nil
+	}
+
+	return float32(f.ValueFloat64())
+}
+
+func strToAny(s types.String) any {
+	if s.IsNull() {
+		return nil
+	}
+
+	return s.ValueString()
+}
+
+// optionalProperties returns the optional settings, sent as null when unset
+// so that an update clears them. The sdk request types omit nil values.
+func optionalProperties(plan PriceModel) map[string]any {
+	return map[string]any{
+		"markupType":    strToAny(plan.MarkupType),
+		"markup":        float64ToAny(plan.Markup),
+		"markupPercent": float64ToAny(plan.MarkupPercent),
+		"customPrice":   float64ToAny(plan.CustomPrice),
+		"platform":      strToAny(plan.Platform),
+		"software":      strToAny(plan.Software),
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan PriceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	price := sdk.NewAddPricesRequestPrice(
+		name,
+		plan.Code.ValueString(),
+		plan.PriceType.ValueString(),
+		plan.PriceUnit.ValueString(),
+		plan.IncurCharges.ValueString(),
+		plan.Currency.ValueString(),
+		float32(plan.Cost.ValueFloat64()),
+	)
+	price.AdditionalProperties = optionalProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create price resource",
+			"price "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	p, hresp, err := client.PricesAPI.AddPrices(ctx).
+		AddPricesRequest(*sdk.NewAddPricesRequest(*price)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create price resource",
+			"price "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.GetPrice().Id == nil {
+		resp.Diagnostics.AddError(
+			"create price resource",
+			"price "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *p.GetPrice().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getPriceAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create price resource",
+			fmt.Sprintf("price %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan PriceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	price := sdk.NewUpdatePricesRequestPrice()
+	price.SetName(name)
+	price.SetCode(plan.Code.ValueString())
+	price.SetPriceType(plan.PriceType.ValueString())
+	price.SetPriceUnit(plan.PriceUnit.ValueString())
+	price.SetIncurCharges(plan.IncurCharges.ValueString())
+	price.SetCurrency(plan.Currency.ValueString())
+	price.SetCost(float32(plan.Cost.ValueFloat64()))
+	price.AdditionalProperties = optionalProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update price resource",
+			"price "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.PricesAPI.UpdatePrices(ctx, id).
+		UpdatePricesRequest(*sdk.NewUpdatePricesRequest(*price)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update price resource",
+			"price "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getPriceAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update price resource",
+			fmt.Sprintf("price %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PriceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read price resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getPriceAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read price resource",
+			fmt.Sprintf("price %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the price was deactivated outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+// Delete deactivates the price, the api doesn't support deleting prices
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PriceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete price resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.PricesAPI.DeactivatePrices(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete price resource",
+			fmt.Sprintf("price %d: deactivate failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import price resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
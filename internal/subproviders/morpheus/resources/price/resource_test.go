@@ -0,0 +1,169 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Memory per GB" Code "memory-gb" Cost 0.015
+
+package price_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusPriceExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Code", name,
+		"Cost", "0.015")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"price_type",
+			"memory",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"cost",
+			"0.015",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"markup_percent",
+			"10",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"currency",
+			"USD",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price.example",
+			"incur_charges",
+			"always",
+		),
+		resource.TestCheckNoResourceAttr(
+			"hpe_morpheus_price.example",
+			"markup",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_price.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusPriceUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_price" "test" {
+  name        = "` + name + `"
+  code        = "` + name + `"
+  price_type  = "fixed"
+  price_unit  = "month"
+  cost        = 10
+  markup_type = "fixed"
+  markup      = 2.5
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_price.test", "markup", "2.5"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the price is updated in place and the markup is cleared
+resource "hpe_morpheus_price" "test" {
+  name          = "` + name + `"
+  code          = "` + name + `"
+  price_type    = "fixed"
+  price_unit    = "month"
+  cost          = 12.75
+  incur_charges = "running"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_price.test", "cost", "12.75"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_price.test", "incur_charges", "running"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_price.test", "markup_type"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_price.test", "markup"),
+				),
+			},
+		},
+	})
+}
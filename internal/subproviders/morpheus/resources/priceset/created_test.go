@@ -0,0 +1,59 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package priceset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestCreatedId(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body  string
+		id    int64
+		found bool
+	}{
+		"price set": {
+			body:  `{"success": true, "priceSet": {"id": 12, "name": "test"}}`,
+			id:    12,
+			found: true,
+		},
+		"budget": {
+			body:  `{"success": true, "budget": {"id": 13, "name": "test"}}`,
+			id:    13,
+			found: true,
+		},
+		"missing": {
+			body:  `{"success": true}`,
+			found: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testclient.NewJSON(t, tc.body)
+
+			p, _, err := client.PriceSetsAPI.AddPriceSets(context.Background()).
+				AddPriceSetsRequest(*sdk.NewAddPriceSetsRequest(
+					*sdk.NewAddPriceSetsRequestPriceSet("test", "test", "month", "fixed"),
+				)).Execute()
+			require.NoError(t, err)
+
+			id, found := createdId(p)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.id, id)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+resource "hpe_morpheus_price" "memory" {
+  name       = "Small VM memory"
+  code       = "small-vm-memory"
+  price_type = "memory"
+  price_unit = "hour"
+  cost       = 0.01
+}
+
+resource "hpe_morpheus_price" "cores" {
+  name       = "Small VM cores"
+  code       = "small-vm-cores"
+  price_type = "cores"
+  price_unit = "hour"
+  cost       = 0.02
+}
+
+resource "hpe_morpheus_price_set" "example" {
+  name       = "Small VM"
+  code       = "small-vm"
+  type       = "component"
+  price_unit = "hour"
+  price_ids = [
+    hpe_morpheus_price.memory.id,
+    hpe_morpheus_price.cores.id,
+  ]
+}
@@ -0,0 +1,399 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package priceset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_price_set"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = PriceSetResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate price set resource model with current API values. The returned
+// bool is false if the price set no longer exists or has been deactivated,
+// price sets can't be deleted.
+func getPriceSetAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (PriceSetModel, bool, diag.Diagnostics) {
+	var state PriceSetModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.PriceSetsAPI.GetPriceSets(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate price set resource",
+			fmt.Sprintf("price set %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	priceSet := p.GetPriceSet()
+	if !priceSet.GetActive() {
+		return state, false, diags
+	}
+
+	state.Id = convert.Int64ToType(priceSet.Id)
+	state.Name = convert.StrToType(priceSet.Name)
+	state.Code = convert.StrToType(priceSet.Code)
+	state.PriceUnit = convert.StrToType(priceSet.PriceUnit)
+	state.Type = convert.StrToType(priceSet.Type)
+	state.RegionCode = convert.StrToTypeEmptyNull(priceSet.RegionCode)
+
+	var priceIds []int64
+	for _, price := range priceSet.Prices {
+		if price.Id != nil {
+			priceIds = append(priceIds, *price.Id)
+		}
+	}
+	state.PriceIds = convert.Int64SliceToSet(priceIds)
+
+	return state, true, diags
+}
+
+// createdId returns the id of a new price set. The sdk models the created
+// price set as "budget", while the api returns it as "priceSet".
+func createdId(p *sdk.AddPriceSets200Response) (int64, bool) {
+	if p.Budget != nil && p.Budget.Id != nil {
+		return *p.Budget.Id, true
+	}
+
+	priceSet, _ := p.AdditionalProperties["priceSet"].(map[string]any)
+	if id, ok := priceSet["id"].(float64); ok {
+		return int64(id), true
+	}
+
+	return 0, false
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan PriceSetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	priceIds, err := convert.SetToInt64Slice(plan.PriceIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create price set resource",
+			"price set "+name+": failed to convert price ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	priceSet := sdk.NewAddPriceSetsRequestPriceSet(
+		name,
+		plan.Code.ValueString(),
+		plan.PriceUnit.ValueString(),
+		plan.Type.ValueString(),
+	)
+	priceSet.SetPrices(priceIds)
+	if !plan.RegionCode.IsNull() {
+		priceSet.SetRegionCode(plan.RegionCode.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create price set resource",
+			"price set "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	p, hresp, err := client.PriceSetsAPI.AddPriceSets(ctx).
+		AddPriceSetsRequest(*sdk.NewAddPriceSetsRequest(*priceSet)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create price set resource",
+			"price set "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	id, ok := createdId(p)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"create price set resource",
+			"price set "+name+": id is nil",
+		)
+
+		return
+	}
+
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getPriceSetAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create price set resource",
+			fmt.Sprintf("price set %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan PriceSetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	// a null set is sent as an empty list to remove all prices
+	priceIds, err := convert.SetToInt64Slice(plan.PriceIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update price set resource",
+			"price set "+name+": failed to convert price ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	priceSet := sdk.NewUpdatePriceSetsRequestPriceSet()
+	priceSet.SetName(name)
+	priceSet.SetCode(plan.Code.ValueString())
+	priceSet.SetPriceUnit(plan.PriceUnit.ValueString())
+	priceSet.SetType(plan.Type.ValueString())
+	priceSet.SetPrices(priceIds)
+	// an empty string clears the region
+	priceSet.SetRegionCode(plan.RegionCode.ValueString())
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update price set resource",
+			"price set "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.PriceSetsAPI.UpdatePriceSets(ctx, id).
+		UpdatePriceSetsRequest(*sdk.NewUpdatePriceSetsRequest(*priceSet)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update price set resource",
+			"price set "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getPriceSetAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update price set resource",
+			fmt.Sprintf("price set %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PriceSetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read price set resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getPriceSetAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read price set resource",
+			fmt.Sprintf("price set %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the price set was deactivated outside of terraform, so plan to
+	// recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+// Delete deactivates the price set, the api doesn't support deleting price
+// sets
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PriceSetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete price set resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.PriceSetsAPI.DeactivatePriceSets(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete price set resource",
+			fmt.Sprintf("price set %d: deactivate failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import price set resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,157 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Small VM" Code "small-vm"
+
+package priceset_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusPriceSetExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Code", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price_set.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price_set.example",
+			"type",
+			"component",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_price_set.example",
+			"price_ids.#",
+			"2",
+		),
+		resource.TestCheckTypeSetElemAttrPair(
+			"hpe_morpheus_price_set.example",
+			"price_ids.*",
+			"hpe_morpheus_price.memory",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_price_set.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusPriceSetUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	price := `
+resource "hpe_morpheus_price" "test" {
+  name       = "` + name + `"
+  code       = "` + name + `"
+  price_type = "fixed"
+  price_unit = "month"
+  cost       = 5
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + price + `
+resource "hpe_morpheus_price_set" "test" {
+  name       = "` + name + `"
+  code       = "` + name + `"
+  type       = "fixed"
+  price_unit = "month"
+  price_ids  = [hpe_morpheus_price.test.id]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_price_set.test", "price_ids.#", "1"),
+				),
+			},
+			{
+				Config: providerConfig + price + `
+# checks the price set is updated in place and the prices are removed
+resource "hpe_morpheus_price_set" "test" {
+  name       = "` + name + `-updated"
+  code       = "` + name + `"
+  type       = "fixed"
+  price_unit = "month"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_price_set.test", "name", name+"-updated"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_price_set.test", "price_ids"),
+				),
+			},
+		},
+	})
+}
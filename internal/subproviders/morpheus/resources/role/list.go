@@ -0,0 +1,168 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/listresult"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+var (
+	_ list.ListResource              = &ListResource{}
+	_ list.ListResourceWithConfigure = &ListResource{}
+)
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+// ListResource lists existing roles for terraform query
+type ListResource struct {
+	configure.ResourceWithMorpheusConfigure
+}
+
+type ListModel struct {
+	Name     types.String `tfsdk:"name"`
+	Phrase   types.String `tfsdk:"phrase"`
+	RoleType types.String `tfsdk:"role_type"`
+}
+
+func (r *ListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_role"
+}
+
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list roles with this name",
+				MarkdownDescription: "Only list roles with this name",
+			},
+			"phrase": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list roles matching this search phrase",
+				MarkdownDescription: "Only list roles matching this search phrase",
+			},
+			"role_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list roles of this type (user or account)",
+				MarkdownDescription: "Only list roles of this type (`user` or `account`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(RoleTypeUser, RoleTypeAccount),
+				},
+			},
+		},
+	}
+}
+
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config ListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		diags.AddError("list role resource", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	// the roles API has no name filter, so narrow the search with the
+	// name as a phrase and match it exactly below
+	phrase := config.Phrase.ValueString()
+	if config.Phrase.IsNull() {
+		phrase = config.Name.ValueString()
+	}
+
+	fetch := func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.ListRoles200ResponseAllOfRolesInner, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		listReq := client.RolesAPI.ListRoles(ctx).Max(pageSize).Offset(offset)
+		if phrase != "" {
+			listReq = listReq.Phrase(phrase)
+		}
+		if !config.RoleType.IsNull() {
+			listReq = listReq.RoleType(config.RoleType.ValueString())
+		}
+
+		roles, hresp, err := listReq.Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("roles GET failed: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return roles.GetRoles(), roles.Meta, nil
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for role, err := range paginate.Seq(ctx, fetch) {
+			if err != nil {
+				push(listresult.Error("list role resource", err.Error()))
+
+				return
+			}
+
+			if role.Id == nil {
+				continue
+			}
+
+			if !config.Name.IsNull() &&
+				role.GetName() != config.Name.ValueString() {
+				continue
+			}
+
+			id := *role.Id
+			result := listresult.New(ctx, req, id, role.GetName(),
+				func() (RoleModel, diag.Diagnostics) {
+					return getRoleAsState(ctx, id, client)
+				},
+			)
+			if !push(result) {
+				return
+			}
+
+			count++
+			if listresult.LimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package role_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/role"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testlist"
+)
+
+func TestListRoles(t *testing.T) {
+	t.Parallel()
+
+	// more than one page of roles
+	const total = 150
+
+	handler := testlist.Handler(t, "/api/roles", "roles", "role", total,
+		func(id int64) map[string]any {
+			return map[string]any{
+				"id":                id,
+				"name":              fmt.Sprintf("role-%d", id),
+				"authority":         fmt.Sprintf("role-%d", id),
+				"description":       "test role",
+				"landingUrl":        nil,
+				"roleType":          "user",
+				"multitenant":       false,
+				"multitenantLocked": false,
+			}
+		},
+	)
+
+	results := testlist.Run(t,
+		role.NewListResource().(list.ListResourceWithConfigure),
+		role.NewResource().(resource.ResourceWithIdentity),
+		true, handler,
+	)
+	require.Len(t, results, total)
+
+	ctx := context.Background()
+	for i, result := range results {
+		id := int64(i + 1)
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, fmt.Sprintf("role-%d", id), result.DisplayName)
+
+		var ident identity.Model
+		require.False(t, result.Identity.Get(ctx, &ident).HasError())
+		assert.Equal(t, types.Int64Value(id), ident.Id)
+
+		var state role.RoleModel
+		require.False(t, result.Resource.Get(ctx, &state).HasError())
+		assert.Equal(t, types.Int64Value(id), state.Id)
+		assert.Equal(t, types.StringValue(fmt.Sprintf("role-%d", id)), state.Name)
+		assert.Equal(t, types.StringValue("test role"), state.Description)
+		assert.True(t, state.LandingUrl.IsNull())
+		assert.Equal(t, types.StringValue("user"), state.RoleType)
+		assert.Equal(t, types.BoolValue(false), state.Multitenant)
+	}
+}
@@ -21,11 +21,14 @@ import (
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &Resource{}
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
 )
 
 func NewResource() resource.Resource {
@@ -54,6 +57,14 @@ func (r *Resource) Schema(
 	resp.Schema = RoleResourceSchema(ctx)
 }
 
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
 // This function breaks out the logic of reading permissions from API response to store to state.
 func populateGetRoleAsStatePermissions(ctx context.Context, r *sdk.GetRole200Response) (PermissionsValue, diag.Diagnostics) {
 
@@ -629,6 +640,9 @@ func (r *Resource) Create(
 
 	// write id as soon as possible
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -932,9 +946,9 @@ func (r *Resource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &apiState)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: apiState.Id})...,
+	)
 }
 
 func (r *Resource) Update(
@@ -979,18 +993,26 @@ func (r *Resource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"import role resource",
-			"provided import ID '"+req.ID+"' is invalid (non-number)",
-		)
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+	} else {
+		id, err := strconv.Atoi(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"import role resource",
+				"provided import ID '"+req.ID+"' is invalid (non-number)",
+			)
 
-		return
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), id)...,
+		)
 	}
 
-	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
-	if diags.HasError() {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
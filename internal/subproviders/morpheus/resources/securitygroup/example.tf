@@ -0,0 +1,6 @@
+resource "hpe_morpheus_security_group" "example" {
+  name        = "Example security group"
+  description = "Web tier access"
+  cloud_id    = 1
+  active      = true
+}
@@ -0,0 +1,16 @@
+resource "hpe_morpheus_security_group" "example" {
+  name     = "Example security group"
+  cloud_id = 1
+}
+
+resource "hpe_morpheus_security_group_rule" "ssh" {
+  security_group_id = hpe_morpheus_security_group.example.id
+  name              = "ssh"
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range        = "22"
+  source_type       = "cidr"
+  source            = "10.0.0.0/8"
+  destination_type  = "instance"
+  policy            = "accept"
+}
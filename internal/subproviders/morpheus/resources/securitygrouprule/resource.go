@@ -0,0 +1,508 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// rules are only ever custom rules, rather than being derived from the
+// ports of an instance type
+const customRule = "customRule"
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+// rules are addressed by security group and rule id
+type identityModel struct {
+	SecurityGroupId types.Int64 `tfsdk:"security_group_id"`
+	Id              types.Int64 `tfsdk:"id"`
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_security_group_rule"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = SecurityGroupRuleResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"security_group_id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the security group",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Morpheus ID of the resource",
+			},
+		},
+	}
+}
+
+func groupIdToType(group *sdk.GetAlerts200ResponseAllOfCheckGroupsInnerInstance) types.Int64 {
+	if group == nil {
+		return types.Int64Null()
+	}
+
+	return convert.Int64ToType(group.Id)
+}
+
+// populate security group rule resource model with current API values. The
+// returned bool is false if the rule no longer exists, e.g. it has been
+// removed through the UI.
+func getSecurityGroupRuleAsState(
+	ctx context.Context,
+	groupId int64,
+	id int64,
+	client *sdk.APIClient,
+) (SecurityGroupRuleModel, bool, diag.Diagnostics) {
+	var state SecurityGroupRuleModel
+	var diags diag.Diagnostics
+
+	ruleId, err := convert.IdToFloat32(id)
+	if err != nil {
+		diags.AddError(
+			"populate security group rule resource",
+			fmt.Sprintf("security group %d: ", groupId)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	r, hresp, err := client.SecurityGroupsAPI.
+		GetSecurityGroupRules(ctx, groupId, ruleId).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate security group rule resource",
+			fmt.Sprintf("security group %d rule %d GET failed: ", groupId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	rule := r.GetRule()
+
+	state.Id = convert.Int64ToType(rule.Id)
+	state.SecurityGroupId = types.Int64Value(groupId)
+	state.Name = convert.StrToTypeEmptyNull(rule.Name.Get())
+	state.Direction = convert.StrToType(rule.Direction)
+	state.Policy = convert.StrToType(rule.Policy)
+	state.Protocol = convert.StrToType(rule.Protocol)
+	state.PortRange = convert.StrToTypeEmptyNull(rule.PortRange.Get())
+	state.SourceType = convert.StrToType(rule.SourceType)
+	state.Source = convert.StrToTypeEmptyNull(rule.Source.Get())
+	state.SourceGroupId = groupIdToType(rule.SourceGroup)
+	state.DestinationType = convert.StrToType(rule.DestinationType)
+	state.Destination = convert.StrToTypeEmptyNull(rule.Destination.Get())
+	state.DestinationGroupId = groupIdToType(rule.DestinationGroup)
+
+	return state, true, diags
+}
+
+// newRule builds the rule sent with both POST and PUT, the sdk models the
+// two identically
+func newRule(plan SecurityGroupRuleModel) *sdk.AddSecurityGroupRulesRequestRule {
+	rule := sdk.NewAddSecurityGroupRulesRequestRule(plan.Protocol.ValueString(), customRule)
+	rule.SetDirection(plan.Direction.ValueString())
+	rule.SetPolicy(plan.Policy.ValueString())
+	rule.SetSourceType(plan.SourceType.ValueString())
+	rule.SetDestinationType(plan.DestinationType.ValueString())
+
+	if !plan.Name.IsNull() {
+		rule.SetName(plan.Name.ValueString())
+	}
+
+	if !plan.PortRange.IsNull() {
+		rule.SetPortRange(plan.PortRange.ValueString())
+	}
+
+	if !plan.Source.IsNull() {
+		rule.SetSource(plan.Source.ValueString())
+	}
+
+	if !plan.SourceGroupId.IsNull() {
+		sourceGroup := sdk.NewAddSecurityGroupRulesRequestRuleSourceGroup()
+		sourceGroup.SetId(plan.SourceGroupId.ValueInt64())
+		rule.SetSourceGroup(*sourceGroup)
+	}
+
+	if !plan.Destination.IsNull() {
+		rule.SetDestination(plan.Destination.ValueString())
+	}
+
+	if !plan.DestinationGroupId.IsNull() {
+		destinationGroup := sdk.NewAddSecurityGroupRulesRequestRuleDestinationGroup()
+		destinationGroup.SetId(plan.DestinationGroupId.ValueInt64())
+		rule.SetDestinationGroup(*destinationGroup)
+	}
+
+	return rule
+}
+
+// newUpdateRule builds the rule sent with PUT, optional attributes removed
+// from the config are sent as null, otherwise the api keeps the old values
+func newUpdateRule(plan SecurityGroupRuleModel) sdk.UpdateSecurityGroupRulesRequestRule {
+	rule := sdk.UpdateSecurityGroupRulesRequestRule(*newRule(plan))
+	rule.AdditionalProperties = map[string]any{}
+
+	optional := map[string]attr.Value{
+		"name":             plan.Name,
+		"portRange":        plan.PortRange,
+		"source":           plan.Source,
+		"sourceGroup":      plan.SourceGroupId,
+		"destination":      plan.Destination,
+		"destinationGroup": plan.DestinationGroupId,
+	}
+	for key, value := range optional {
+		if value.IsNull() {
+			rule.AdditionalProperties[key] = nil
+		}
+	}
+
+	return rule
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SecurityGroupRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create security group rule resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	groupId := plan.SecurityGroupId.ValueInt64()
+
+	addRuleReq := sdk.NewAddSecurityGroupRulesRequest(*newRule(plan))
+
+	rule, hresp, err := client.SecurityGroupsAPI.AddSecurityGroupRules(ctx, groupId).
+		AddSecurityGroupRulesRequest(*addRuleReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create security group rule resource",
+			fmt.Sprintf("security group %d rule POST failed: ", groupId)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if rule.GetRule().Id == nil {
+		resp.Diagnostics.AddError(
+			"create security group rule resource",
+			fmt.Sprintf("security group %d rule: id is nil", groupId),
+		)
+
+		return
+	}
+
+	id := *rule.GetRule().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			SecurityGroupId: plan.SecurityGroupId,
+			Id:              plan.Id,
+		})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getSecurityGroupRuleAsState(ctx, groupId, id, client)
+	if !found || pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create security group rule resource",
+			fmt.Sprintf("security group %d rule %d: failed to read from api", groupId, id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan SecurityGroupRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update security group rule resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	groupId := plan.SecurityGroupId.ValueInt64()
+	id := plan.Id.ValueInt64()
+
+	ruleId, err := convert.IdToFloat32(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update security group rule resource",
+			fmt.Sprintf("security group %d: ", groupId)+err.Error(),
+		)
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	updateRuleReq := sdk.NewUpdateSecurityGroupRulesRequest(newUpdateRule(plan))
+
+	_, hresp, err := client.SecurityGroupsAPI.
+		UpdateSecurityGroupRules(ctx, groupId, ruleId).
+		UpdateSecurityGroupRulesRequest(*updateRuleReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update security group rule resource",
+			fmt.Sprintf("security group %d rule %d PUT failed: ", groupId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getSecurityGroupRuleAsState(ctx, groupId, id, client)
+	if !found || pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update security group rule resource",
+			fmt.Sprintf("security group %d rule %d: failed to read from api", groupId, id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			SecurityGroupId: state.SecurityGroupId,
+			Id:              state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SecurityGroupRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read security group rule resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	groupId := data.SecurityGroupId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	state, found, pdiags := getSecurityGroupRuleAsState(ctx, groupId, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read security group rule resource",
+			fmt.Sprintf("security group %d rule %d: failed to read from api", groupId, id),
+		)
+
+		return
+	}
+
+	// the rule was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identityModel{
+			SecurityGroupId: state.SecurityGroupId,
+			Id:              state.Id,
+		})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SecurityGroupRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := data.SecurityGroupId.ValueInt64()
+	id := data.Id.ValueInt64()
+
+	ruleId, err := convert.IdToFloat32(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete security group rule resource",
+			fmt.Sprintf("security group %d: ", groupId)+err.Error(),
+		)
+
+		return
+	}
+
+	ctx = clientfactory.WithFloat32Ids(ctx, id)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete security group rule resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.SecurityGroupsAPI.
+		RemoveSecurityGroupRules(ctx, groupId, ruleId).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete security group rule resource",
+			fmt.Sprintf("security group %d rule %d: DELETE failed ", groupId, id)+
+				errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		var m identityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &m)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("security_group_id"), m.SecurityGroupId)...,
+		)
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(ctx, path.Root("id"), m.Id)...,
+		)
+
+		return
+	}
+
+	groupIdStr, idStr, found := strings.Cut(req.ID, "/")
+	groupId, groupErr := strconv.ParseInt(groupIdStr, 10, 64)
+	id, idErr := strconv.ParseInt(idStr, 10, 64)
+	if !found || groupErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"import security group rule resource",
+			"provided import ID '"+req.ID+"' is invalid (expected <security_group_id>/<id>)",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("security_group_id"), groupId)...,
+	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), id)...,
+	)
+}
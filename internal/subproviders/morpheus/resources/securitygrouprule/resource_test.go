@@ -0,0 +1,233 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl GroupName "Example security group" CloudId 1 Name "ssh" PortRange "22" Source "10.0.0.0/8"
+
+package securitygrouprule_test
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+func importStateId(s *terraform.State) (string, error) {
+	groupId, err := testhelpers.ExtractValue(
+		s, "hpe_morpheus_security_group_rule.ssh", "security_group_id")
+	if err != nil {
+		return "", err
+	}
+
+	id, err := testhelpers.ExtractValue(s, "hpe_morpheus_security_group_rule.ssh", "id")
+	if err != nil {
+		return "", err
+	}
+
+	return groupId + "/" + id, nil
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusSecurityGroupRuleExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"GroupName", name,
+		"CloudId", "4617",
+		"Name", "ssh",
+		"PortRange", "22",
+		"Source", "10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_security_group_rule.ssh",
+			"security_group_id",
+			"hpe_morpheus_security_group.example",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"name",
+			"ssh",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"direction",
+			"ingress",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"protocol",
+			"tcp",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"port_range",
+			"22",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"source_type",
+			"cidr",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"source",
+			"10.0.0.0/8",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_security_group_rule.ssh",
+			"policy",
+			"accept",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ImportStateIdFunc: importStateId,
+				ResourceName:      "hpe_morpheus_security_group_rule.ssh",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+// Tests that rules edited or deleted outside of terraform are put back
+func TestAccMorpheusSecurityGroupRuleDriftOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	config := providerConfig + `
+resource "hpe_morpheus_security_group" "test" {
+  name     = "` + name + `"
+  cloud_id = 4617
+}
+
+resource "hpe_morpheus_security_group_rule" "ssh" {
+  security_group_id = hpe_morpheus_security_group.test.id
+  protocol          = "tcp"
+  port_range        = "22"
+  source_type       = "cidr"
+  source            = "10.0.0.0/8"
+}`
+
+	var groupId, id int64
+	saveIds := func(s *terraform.State) error {
+		v, err := testhelpers.ExtractValue(
+			s, "hpe_morpheus_security_group_rule.ssh", "security_group_id")
+		if err != nil {
+			return err
+		}
+		groupId, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		v, err = testhelpers.ExtractValue(s, "hpe_morpheus_security_group_rule.ssh", "id")
+		if err != nil {
+			return err
+		}
+		id, err = strconv.ParseInt(v, 10, 64)
+
+		return err
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  saveIds,
+			},
+			{
+				PreConfig: func() {
+					err := testhelpers.UpdateSecurityGroupRulePortRange(t, groupId, id, "2222")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_security_group_rule.ssh", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"hpe_morpheus_security_group_rule.ssh", "port_range", "22"),
+			},
+			{
+				PreConfig: func() {
+					err := testhelpers.DeleteSecurityGroupRule(t, groupId, id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_security_group_rule.ssh", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"hpe_morpheus_security_group_rule.ssh", "port_range", "22"),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package securitygrouprule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetSecurityGroupRuleAsState(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/security-groups/3/rules/7" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"rule": {
+			"id": 7,
+			"name": "",
+			"ruleType": "customRule",
+			"direction": "ingress",
+			"policy": "accept",
+			"sourceType": "group",
+			"source": null,
+			"sourceGroup": {"id": 4, "name": "web"},
+			"portRange": "8080-8081",
+			"protocol": "tcp",
+			"destinationType": "cidr",
+			"destination": "10.0.0.0/24"
+		}}`)
+	})
+
+	state, found, diags := getSecurityGroupRuleAsState(context.Background(), 3, 7, client)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, types.Int64Value(7), state.Id)
+	assert.Equal(t, types.Int64Value(3), state.SecurityGroupId)
+	assert.True(t, state.Name.IsNull())
+	assert.Equal(t, types.StringValue("ingress"), state.Direction)
+	assert.Equal(t, types.StringValue("accept"), state.Policy)
+	assert.Equal(t, types.StringValue("tcp"), state.Protocol)
+	assert.Equal(t, types.StringValue("8080-8081"), state.PortRange)
+	assert.Equal(t, types.StringValue("group"), state.SourceType)
+	assert.True(t, state.Source.IsNull())
+	assert.Equal(t, types.Int64Value(4), state.SourceGroupId)
+	assert.Equal(t, types.StringValue("cidr"), state.DestinationType)
+	assert.Equal(t, types.StringValue("10.0.0.0/24"), state.Destination)
+	assert.True(t, state.DestinationGroupId.IsNull())
+}
+
+func TestGetSecurityGroupRuleAsStateDeleted(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "msg": "Rule not found"}`)
+	})
+
+	_, found, diags := getSecurityGroupRuleAsState(context.Background(), 3, 7, client)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
+
+func TestGetSecurityGroupRuleAsStateError(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, found, diags := getSecurityGroupRuleAsState(context.Background(), 3, 7, client)
+	assert.True(t, diags.HasError())
+	assert.True(t, found)
+}
+
+func TestGetSecurityGroupRuleAsStateUnsupportedId(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	_, _, diags := getSecurityGroupRuleAsState(context.Background(), 3, 16777217, client)
+	assert.True(t, diags.HasError())
+}
+
+func TestUpdateRuleClearsRemovedFields(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]map[string]any
+
+	client := testclient.New(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/security-groups/3/rules/7", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true}`)
+	})
+
+	plan := SecurityGroupRuleModel{
+		Id:                 types.Int64Value(7),
+		SecurityGroupId:    types.Int64Value(3),
+		Name:               types.StringValue("web"),
+		Direction:          types.StringValue("ingress"),
+		Policy:             types.StringValue("accept"),
+		Protocol:           types.StringValue("tcp"),
+		PortRange:          types.StringNull(),
+		SourceType:         types.StringValue("cidr"),
+		Source:             types.StringValue("10.0.0.0/24"),
+		SourceGroupId:      types.Int64Null(),
+		DestinationType:    types.StringValue("instance"),
+		Destination:        types.StringNull(),
+		DestinationGroupId: types.Int64Null(),
+	}
+
+	req := sdk.NewUpdateSecurityGroupRulesRequest(newUpdateRule(plan))
+	_, _, err := client.SecurityGroupsAPI.UpdateSecurityGroupRules(context.Background(), 3, 7).
+		UpdateSecurityGroupRulesRequest(*req).Execute()
+	require.NoError(t, err)
+
+	rule := body["rule"]
+	require.NotNil(t, rule)
+
+	for _, key := range []string{"portRange", "sourceGroup", "destination", "destinationGroup"} {
+		value, ok := rule[key]
+		assert.True(t, ok, key)
+		assert.Nil(t, value, key)
+	}
+
+	assert.Equal(t, "web", rule["name"])
+	assert.Equal(t, "10.0.0.0/24", rule["source"])
+	assert.Equal(t, "tcp", rule["protocol"])
+}
@@ -0,0 +1,29 @@
+resource "hpe_morpheus_price" "example" {
+  name       = "Small"
+  code       = "small"
+  price_type = "fixed"
+  price_unit = "month"
+  cost       = 25
+}
+
+resource "hpe_morpheus_price_set" "example" {
+  name       = "Small"
+  code       = "small"
+  type       = "fixed"
+  price_unit = "month"
+  price_ids  = [hpe_morpheus_price.example.id]
+}
+
+resource "hpe_morpheus_service_plan" "example" {
+  name                = "Small"
+  code                = "small"
+  description         = "2 cores, 4GB memory, 40GB disk"
+  provision_type_code = "vmware"
+  max_cores           = 2
+  cores_per_socket    = 1
+  max_memory          = 4294967296
+  max_storage         = 42949672960
+  custom_max_storage  = true
+  add_volumes         = true
+  price_set_ids       = [hpe_morpheus_price_set.example.id]
+}
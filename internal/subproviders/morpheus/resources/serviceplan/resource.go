@@ -0,0 +1,502 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package serviceplan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_service_plan"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = ServicePlanResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// unset flags are returned as null, which is the same as false
+func boolToType(b *bool) types.Bool {
+	if b == nil {
+		return types.BoolValue(false)
+	}
+
+	return types.BoolValue(*b)
+}
+
+// populate service plan resource model with current API values. The
+// returned bool is false if the service plan no longer exists.
+func getServicePlanAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (ServicePlanModel, bool, diag.Diagnostics) {
+	var state ServicePlanModel
+	var diags diag.Diagnostics
+
+	p, hresp, err := client.ServicePlansAPI.GetServicePlans(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate service plan resource",
+			fmt.Sprintf("service plan %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	plan := p.GetServicePlan()
+
+	state.Id = convert.Int64ToType(plan.Id)
+	state.Name = convert.StrToType(plan.Name)
+	state.Code = convert.StrToType(plan.Code)
+	state.Description = convert.StrToTypeEmptyNull(plan.Description)
+	state.Editable = boolToType(plan.Editable)
+	state.SortOrder = convert.Int64ToType(plan.SortOrder)
+	state.MaxStorage = convert.Int64ToType(plan.MaxStorage)
+	state.MaxMemory = convert.Int64ToType(plan.MaxMemory)
+	state.MaxCores = convert.Int64ToType(plan.MaxCores.Get())
+	state.CoresPerSocket = convert.Int64ToType(plan.CoresPerSocket.Get())
+	state.MaxDisks = convert.Int64ToType(plan.MaxDisks.Get())
+	state.CustomCores = boolToType(plan.CustomCores)
+	state.CustomMaxStorage = boolToType(plan.CustomMaxStorage.Get())
+	state.CustomMaxDataStorage = boolToType(plan.CustomMaxDataStorage.Get())
+	state.CustomMaxMemory = boolToType(plan.CustomMaxMemory.Get())
+	state.AddVolumes = boolToType(plan.AddVolumes.Get())
+
+	provisionType := plan.GetProvisionType()
+	state.ProvisionTypeCode = convert.StrToType(provisionType.Code)
+
+	var priceSetIds []int64
+	for _, ps := range plan.PriceSets {
+		if ps.Id != nil {
+			priceSetIds = append(priceSetIds, *ps.Id)
+		}
+	}
+	state.PriceSetIds = convert.Int64SliceToSet(priceSetIds)
+
+	return state, true, diags
+}
+
+// getProvisionTypeId looks up the id of a provision type, which the api
+// requires rather than the code
+func getProvisionTypeId(
+	ctx context.Context,
+	code string,
+	client *sdk.APIClient,
+) (int64, error) {
+	p, hresp, err := client.ProvisioningAPI.ListProvisionTypes(ctx).Code(code).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf(
+			"provision types GET failed: %s", errors.ErrMsg(err, hresp),
+		)
+	}
+
+	for _, pt := range p.GetProvisionTypes() {
+		if pt.GetCode() == code && pt.Id != nil {
+			return *pt.Id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("provision type %s not found", code)
+}
+
+// newPriceSets converts the price set ids, a null set is sent as an empty
+// list to unlink all price sets
+func newPriceSets(
+	plan ServicePlanModel,
+) ([]sdk.AddServicePlansRequestServicePlanPriceSetsInner, error) {
+	ids, err := convert.SetToInt64Slice(plan.PriceSetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	priceSets := []sdk.AddServicePlansRequestServicePlanPriceSetsInner{}
+	for _, id := range ids {
+		ps := sdk.NewAddServicePlansRequestServicePlanPriceSetsInner()
+		ps.SetId(id)
+		priceSets = append(priceSets, *ps)
+	}
+
+	return priceSets, nil
+}
+
+// sizeProperties returns the sizes for an update, which the sdk types as
+// float32 and so can't represent every byte count
+func sizeProperties(plan ServicePlanModel) map[string]any {
+	properties := map[string]any{
+		"maxStorage": plan.MaxStorage.ValueInt64(),
+		"maxMemory":  plan.MaxMemory.ValueInt64(),
+	}
+
+	if !plan.MaxCores.IsNull() && !plan.MaxCores.IsUnknown() {
+		properties["maxCores"] = plan.MaxCores.ValueInt64()
+	}
+
+	if !plan.CoresPerSocket.IsNull() && !plan.CoresPerSocket.IsUnknown() {
+		properties["coresPerSocket"] = plan.CoresPerSocket.ValueInt64()
+	}
+
+	if !plan.MaxDisks.IsNull() && !plan.MaxDisks.IsUnknown() {
+		properties["maxDisks"] = plan.MaxDisks.ValueInt64()
+	}
+
+	if !plan.SortOrder.IsNull() && !plan.SortOrder.IsUnknown() {
+		properties["sortOrder"] = plan.SortOrder.ValueInt64()
+	}
+
+	return properties
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ServicePlanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	priceSets, err := newPriceSets(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			"service plan "+name+": failed to convert price set ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			"service plan "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	provisionTypeId, err := getProvisionTypeId(ctx, plan.ProvisionTypeCode.ValueString(), client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			"service plan "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	servicePlan := sdk.NewAddServicePlansRequestServicePlan(
+		name,
+		plan.Code.ValueString(),
+		plan.MaxStorage.ValueInt64(),
+		plan.MaxMemory.ValueInt64(),
+		*sdk.NewAddClusterLayoutsRequestLayoutProvisionType(provisionTypeId),
+	)
+	servicePlan.SetEditable(plan.Editable.ValueBool())
+	servicePlan.SetCustomCores(plan.CustomCores.ValueBool())
+	servicePlan.SetCustomMaxStorage(plan.CustomMaxStorage.ValueBool())
+	servicePlan.SetCustomMaxDataStorage(plan.CustomMaxDataStorage.ValueBool())
+	servicePlan.SetCustomMaxMemory(plan.CustomMaxMemory.ValueBool())
+	servicePlan.SetAddVolumes(plan.AddVolumes.ValueBool())
+	servicePlan.SetPriceSets(priceSets)
+	if !plan.Description.IsNull() {
+		servicePlan.SetDescription(plan.Description.ValueString())
+	}
+	if !plan.MaxCores.IsUnknown() && !plan.MaxCores.IsNull() {
+		servicePlan.SetMaxCores(plan.MaxCores.ValueInt64())
+	}
+	if !plan.CoresPerSocket.IsUnknown() && !plan.CoresPerSocket.IsNull() {
+		servicePlan.SetCoresPerSocket(plan.CoresPerSocket.ValueInt64())
+	}
+	if !plan.MaxDisks.IsUnknown() && !plan.MaxDisks.IsNull() {
+		servicePlan.SetMaxDisks(plan.MaxDisks.ValueInt64())
+	}
+	if !plan.SortOrder.IsUnknown() && !plan.SortOrder.IsNull() {
+		servicePlan.SetSortOrder(plan.SortOrder.ValueInt64())
+	}
+
+	p, hresp, err := client.ServicePlansAPI.AddServicePlans(ctx).
+		AddServicePlansRequest(*sdk.NewAddServicePlansRequest(*servicePlan)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			"service plan "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if p.Id == nil {
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			"service plan "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *p.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getServicePlanAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create service plan resource",
+			fmt.Sprintf("service plan %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan ServicePlanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	priceSets, err := newPriceSets(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update service plan resource",
+			"service plan "+name+": failed to convert price set ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	servicePlan := sdk.NewUpdateServicePlansRequestServicePlan()
+	servicePlan.SetName(name)
+	servicePlan.SetCode(plan.Code.ValueString())
+	// an empty string clears the description
+	servicePlan.SetDescription(plan.Description.ValueString())
+	servicePlan.SetEditable(plan.Editable.ValueBool())
+	servicePlan.SetCustomCores(plan.CustomCores.ValueBool())
+	servicePlan.SetCustomMaxStorage(plan.CustomMaxStorage.ValueBool())
+	servicePlan.SetCustomMaxDataStorage(plan.CustomMaxDataStorage.ValueBool())
+	servicePlan.SetCustomMaxMemory(plan.CustomMaxMemory.ValueBool())
+	servicePlan.SetAddVolumes(plan.AddVolumes.ValueBool())
+	servicePlan.SetPriceSets(priceSets)
+	servicePlan.AdditionalProperties = sizeProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update service plan resource",
+			"service plan "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.ServicePlansAPI.UpdateServicePlans(ctx, id).
+		UpdateServicePlansRequest(*sdk.NewUpdateServicePlansRequest(*servicePlan)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update service plan resource",
+			"service plan "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getServicePlanAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update service plan resource",
+			fmt.Sprintf("service plan %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ServicePlanModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read service plan resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getServicePlanAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read service plan resource",
+			fmt.Sprintf("service plan %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the service plan was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ServicePlanModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete service plan resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.ServicePlansAPI.RemoveServicePlans(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete service plan resource",
+			fmt.Sprintf("service plan %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import service plan resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
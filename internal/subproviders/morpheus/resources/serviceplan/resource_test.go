@@ -0,0 +1,176 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Small" Code "small" ProvisionTypeCode "vmware"
+
+package serviceplan_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusServicePlanExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Code", name,
+		"ProvisionTypeCode", "vmware")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"provision_type_code",
+			"vmware",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"max_cores",
+			"2",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"max_memory",
+			"4294967296",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"max_storage",
+			"42949672960",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"custom_max_storage",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_service_plan.example",
+			"custom_cores",
+			"false",
+		),
+		resource.TestCheckTypeSetElemAttrPair(
+			"hpe_morpheus_service_plan.example",
+			"price_set_ids.*",
+			"hpe_morpheus_price_set.example",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_service_plan.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusServicePlanUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_service_plan" "test" {
+  name                = "` + name + `"
+  code                = "` + name + `"
+  description         = "before"
+  provision_type_code = "vmware"
+  max_cores           = 1
+  max_memory          = 1073741824
+  max_storage         = 10737418240
+}`,
+			},
+			{
+				Config: providerConfig + `
+# checks the plan is resized in place and the description is cleared
+resource "hpe_morpheus_service_plan" "test" {
+  name                = "` + name + `"
+  code                = "` + name + `"
+  provision_type_code = "vmware"
+  max_cores           = 4
+  max_memory          = 8589934592
+  max_storage         = 107374182400
+  custom_cores        = true
+  custom_max_memory   = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_service_plan.test", "max_cores", "4"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_service_plan.test", "max_memory", "8589934592"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_service_plan.test", "max_storage", "107374182400"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_service_plan.test", "custom_cores", "true"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_service_plan.test", "description"),
+				),
+			},
+		},
+	})
+}
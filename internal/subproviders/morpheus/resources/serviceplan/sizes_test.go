@@ -0,0 +1,45 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package serviceplan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Sizes are sent as integers rather than the sdk's float32, which would
+// round byte counts above 2^24
+func TestSizePropertiesExact(t *testing.T) {
+	t.Parallel()
+
+	plan := ServicePlanModel{
+		MaxStorage:     types.Int64Value(10737418241),
+		MaxMemory:      types.Int64Value(4294967297),
+		MaxCores:       types.Int64Value(4),
+		CoresPerSocket: types.Int64Null(),
+		MaxDisks:       types.Int64Unknown(),
+		SortOrder:      types.Int64Value(16777217),
+	}
+
+	servicePlan := sdk.NewUpdateServicePlansRequestServicePlan()
+	servicePlan.AdditionalProperties = sizeProperties(plan)
+
+	b, err := json.Marshal(sdk.NewUpdateServicePlansRequest(*servicePlan))
+	require.NoError(t, err)
+
+	// editable is defaulted by the sdk constructor
+	assert.JSONEq(t, `{"servicePlan": {
+		"editable": true,
+		"maxStorage": 10737418241,
+		"maxMemory": 4294967297,
+		"maxCores": 4,
+		"sortOrder": 16777217
+	}}`, string(b))
+}
@@ -0,0 +1,15 @@
+resource "hpe_morpheus_subnet" "example" {
+  name          = "Example subnet"
+  network_id    = 5
+  cidr          = "10.0.1.0/24"
+  gateway       = "10.0.1.1"
+  dns_primary   = "10.0.0.2"
+  dns_secondary = "10.0.0.3"
+  dhcp_server   = true
+  visibility    = "private"
+  pool_id       = 7
+
+  resource_permissions = {
+    all = true
+  }
+}
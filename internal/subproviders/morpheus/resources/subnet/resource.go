@@ -0,0 +1,565 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package subnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/permissions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_subnet"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = SubnetResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// decodeSubnet decodes the subnet from the body of a subnet response, which
+// the sdk leaves readable after decoding. The sdk models the pool as a
+// string, while the api returns it as an object, so the pool is removed
+// before the rest of the subnet is decoded to the sdk model.
+func decodeSubnet(
+	hresp *http.Response,
+) (sdk.GetNetworkSubnets200ResponseAllOfSubnetsInner, types.Int64, error) {
+	var subnet sdk.GetNetworkSubnets200ResponseAllOfSubnetsInner
+	var body struct {
+		Subnet map[string]any `json:"subnet"`
+	}
+
+	if err := json.NewDecoder(hresp.Body).Decode(&body); err != nil {
+		return subnet, types.Int64Null(), err
+	}
+
+	if body.Subnet == nil {
+		return subnet, types.Int64Null(), fmt.Errorf("subnet missing from response")
+	}
+
+	poolId := types.Int64Null()
+	switch pool := body.Subnet["pool"].(type) {
+	case map[string]any:
+		if id, ok := pool["id"].(float64); ok {
+			poolId = types.Int64Value(int64(id))
+		}
+	case float64:
+		poolId = types.Int64Value(int64(pool))
+	}
+	delete(body.Subnet, "pool")
+
+	b, err := json.Marshal(body.Subnet)
+	if err != nil {
+		return subnet, types.Int64Null(), err
+	}
+
+	if err := json.Unmarshal(b, &subnet); err != nil {
+		return subnet, types.Int64Null(), err
+	}
+
+	return subnet, poolId, nil
+}
+
+// populate subnet resource model with current API values. The returned bool
+// is false if the subnet no longer exists.
+func getSubnetAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (SubnetModel, bool, diag.Diagnostics) {
+	var state SubnetModel
+	var diags diag.Diagnostics
+
+	// the sdk fails to decode a subnet with a pool, so the subnet is
+	// decoded from the response body
+	_, hresp, err := client.NetworksAPI.GetSubnet(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if hresp == nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate subnet resource",
+			fmt.Sprintf("subnet %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	subnet, poolId, err := decodeSubnet(hresp)
+	if err != nil {
+		diags.AddError(
+			"populate subnet resource",
+			fmt.Sprintf("subnet %d: failed to decode response: ", id)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	state.Id = convert.Int64ToType(subnet.Id)
+	state.Name = convert.StrToType(subnet.Name)
+	state.Description = convert.StrToType(subnet.Description.Get())
+	state.Active = convert.BoolToType(subnet.Active)
+	state.Cidr = convert.StrToType(subnet.Cidr)
+	state.Gateway = convert.StrToType(subnet.Gateway.Get())
+	state.DhcpServer = convert.BoolToType(subnet.DhcpServer)
+	state.DnsPrimary = convert.StrToType(subnet.DnsPrimary.Get())
+	state.DnsSecondary = convert.StrToType(subnet.DnsSecondary.Get())
+	state.SearchDomains = convert.StrToType(subnet.SearchDomains.Get())
+	state.Visibility = convert.StrToType(subnet.Visibility)
+
+	state.PoolId = poolId
+
+	if subnet.Network != nil {
+		state.NetworkId = convert.Int64ToType(subnet.Network.Id)
+	} else {
+		state.NetworkId = types.Int64Null()
+	}
+
+	if subnet.Type != nil {
+		state.TypeId = convert.Int64ToType(subnet.Type.Id)
+	} else {
+		state.TypeId = types.Int64Null()
+	}
+
+	var tenantIds []int64
+	for _, tenant := range subnet.Tenants {
+		if tenant.Id != nil {
+			tenantIds = append(tenantIds, *tenant.Id)
+		}
+	}
+	state.TenantIds = convert.Int64SliceToSet(tenantIds)
+
+	resourcePermission, ok := subnet.GetResourcePermissionOk()
+	if ok {
+		resourcePermissions, d := permissions.Value(
+			ctx,
+			resourcePermission,
+			ResourcePermissionsValue{}.AttributeTypes(ctx),
+			NewResourcePermissionsValue,
+		)
+		diags.Append(d...)
+		if diags.HasError() {
+			return state, true, diags
+		}
+		state.ResourcePermissions = resourcePermissions
+	} else {
+		state.ResourcePermissions = NewResourcePermissionsValueNull()
+	}
+
+	return state, true, diags
+}
+
+// newSubnetRequest builds the request body shared by POST and PUT. As with
+// the network resource, only known values are sent.
+func newSubnetRequest(
+	ctx context.Context,
+	plan SubnetModel,
+) (*sdk.CreateSubnetRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	subnet := sdk.NewCreateSubnetRequestSubnet()
+
+	// most subnet attributes aren't modelled by the sdk request type
+	additional := map[string]any{
+		"name": plan.Name.ValueString(),
+		"cidr": plan.Cidr.ValueString(),
+	}
+
+	if !plan.NetworkId.IsNull() && !plan.NetworkId.IsUnknown() {
+		additional["network"] = map[string]any{"id": plan.NetworkId.ValueInt64()}
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		additional["description"] = plan.Description.ValueString()
+	}
+
+	if !plan.Active.IsNull() && !plan.Active.IsUnknown() {
+		additional["active"] = plan.Active.ValueBool()
+	}
+
+	if !plan.Gateway.IsNull() && !plan.Gateway.IsUnknown() {
+		additional["gateway"] = plan.Gateway.ValueString()
+	}
+
+	if !plan.DnsPrimary.IsNull() && !plan.DnsPrimary.IsUnknown() {
+		additional["dnsPrimary"] = plan.DnsPrimary.ValueString()
+	}
+
+	if !plan.DnsSecondary.IsNull() && !plan.DnsSecondary.IsUnknown() {
+		additional["dnsSecondary"] = plan.DnsSecondary.ValueString()
+	}
+
+	if !plan.DhcpServer.IsNull() && !plan.DhcpServer.IsUnknown() {
+		additional["dhcpServer"] = plan.DhcpServer.ValueBool()
+	}
+
+	if !plan.SearchDomains.IsNull() && !plan.SearchDomains.IsUnknown() {
+		additional["searchDomains"] = plan.SearchDomains.ValueString()
+	}
+
+	if !plan.PoolId.IsNull() && !plan.PoolId.IsUnknown() {
+		additional["pool"] = plan.PoolId.ValueInt64()
+	}
+
+	subnet.AdditionalProperties = additional
+
+	if !plan.Visibility.IsNull() && !plan.Visibility.IsUnknown() {
+		subnet.SetVisibility(plan.Visibility.ValueString())
+	}
+
+	if !plan.TypeId.IsNull() && !plan.TypeId.IsUnknown() {
+		subnetType := sdk.NewCreateSubnetRequestSubnetType()
+		subnetType.SetId(plan.TypeId.ValueInt64())
+		subnet.SetType(*subnetType)
+	}
+
+	if !plan.TenantIds.IsNull() && !plan.TenantIds.IsUnknown() {
+		var tenantIds []int64
+		diags.Append(plan.TenantIds.ElementsAs(ctx, &tenantIds, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tenants := []sdk.GetAlerts200ResponseAllOfChecksInnerAccount{}
+		for _, id := range tenantIds {
+			tenant := sdk.GetAlerts200ResponseAllOfChecksInnerAccount{}
+			tenant.SetId(id)
+			tenants = append(tenants, tenant)
+		}
+		subnet.SetTenants(tenants)
+	}
+
+	subnetReq := sdk.NewCreateSubnetRequest()
+	subnetReq.SetSubnet(*subnet)
+
+	if !plan.ResourcePermissions.IsNull() &&
+		!plan.ResourcePermissions.IsUnknown() {
+		resourcePermission := sdk.NewCreateSubnetRequestResourcePermission()
+		resourcePermission.SetAll(plan.ResourcePermissions.All.ValueBool())
+
+		if !plan.ResourcePermissions.GroupIds.IsNull() &&
+			!plan.ResourcePermissions.GroupIds.IsUnknown() {
+			var groupIds []int64
+			diags.Append(
+				plan.ResourcePermissions.GroupIds.ElementsAs(ctx, &groupIds, false)...,
+			)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			sites := []sdk.GetAlerts200ResponseAllOfChecksInnerAccount{}
+			for _, id := range groupIds {
+				site := sdk.GetAlerts200ResponseAllOfChecksInnerAccount{}
+				site.SetId(id)
+				sites = append(sites, site)
+			}
+			resourcePermission.SetSites(sites)
+		}
+
+		subnetReq.SetResourcePermission(*resourcePermission)
+	}
+
+	return subnetReq, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SubnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	subnetReq, diags := newSubnetRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create subnet resource",
+			"subnet "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	// as with GET, the subnet is decoded from the response body
+	_, hresp, err := client.NetworksAPI.CreateSubnet(ctx).
+		CreateSubnetRequest(*subnetReq).Execute()
+	if hresp == nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create subnet resource",
+			"subnet "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	created, _, err := decodeSubnet(hresp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create subnet resource",
+			"subnet "+name+": failed to decode response: "+err.Error(),
+		)
+
+		return
+	}
+
+	if created.Id == nil {
+		resp.Diagnostics.AddError(
+			"create subnet resource",
+			"subnet "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *created.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getSubnetAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create subnet resource",
+			fmt.Sprintf("subnet %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, data SubnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	name := plan.Name.ValueString()
+
+	subnetReq, diags := newSubnetRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update subnet resource",
+			"subnet "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	// the response isn't used, so errors decoding a subnet with a pool are
+	// ignored
+	_, hresp, err := client.NetworksAPI.UpdateSubnet(ctx, id).
+		CreateSubnetRequest(*subnetReq).Execute()
+	if hresp == nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update subnet resource",
+			fmt.Sprintf("subnet %d PUT failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getSubnetAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update subnet resource",
+			fmt.Sprintf("subnet %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SubnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read subnet resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getSubnetAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read subnet resource",
+			fmt.Sprintf("subnet %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the subnet was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SubnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete subnet resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.NetworksAPI.DeleteSubnet(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete subnet resource",
+			fmt.Sprintf("subnet %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import subnet resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,234 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example subnet" NetworkId 5 Cidr "10.0.1.0/24" Gateway "10.0.1.1" DnsPrimary "10.0.0.2" DnsSecondary "10.0.0.3" Visibility "private" PoolId 7
+
+package subnet_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// parent network for the subnets under test, using the same cloud, group
+// and type as the network resource tests
+func networkConfig(name string) string {
+	return `
+resource "hpe_morpheus_network" "test" {
+  name     = "` + name + `"
+  cloud_id = 4617
+  group_id = 1
+  type_id  = 35
+  cidr     = "10.0.0.0/8"
+  config = {
+    "resourceGroupId" = "example-resource-group"
+    "subnetName"      = "example-subnet"
+    "subnetCidr"      = "10.0.1.0/24"
+  }
+}
+`
+}
+
+// ip pool assigned to the subnets under test
+func poolConfig(name string) string {
+	return `
+resource "hpe_morpheus_network_pool" "test" {
+  name = "` + name + `"
+
+  ip_ranges = [
+    {
+      start_address = "10.0.2.10"
+      end_address   = "10.0.2.99"
+    },
+  ]
+}
+`
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusSubnetExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"NetworkId", "hpe_morpheus_network.test.id",
+		"Cidr", "10.0.2.0/24",
+		"Gateway", "10.0.2.1",
+		"DnsPrimary", "10.0.0.2",
+		"DnsSecondary", "10.0.0.3",
+		"Visibility", "public",
+		"PoolId", "hpe_morpheus_network_pool.test.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_subnet.example",
+			"network_id",
+			"hpe_morpheus_network.test",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"cidr",
+			"10.0.2.0/24",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"gateway",
+			"10.0.2.1",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"dns_primary",
+			"10.0.0.2",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"dns_secondary",
+			"10.0.0.3",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"dhcp_server",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"visibility",
+			"public",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_subnet.example",
+			"resource_permissions.all",
+			"true",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_subnet.example",
+			"pool_id",
+			"hpe_morpheus_network_pool.test",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + networkConfig(name) + poolConfig(name) +
+					resourceConfig,
+				Check: checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_subnet.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusSubnetUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + networkConfig(name) + `
+resource "hpe_morpheus_subnet" "test" {
+  name        = "` + name + `"
+  network_id  = hpe_morpheus_network.test.id
+  cidr        = "10.0.3.0/24"
+  dns_primary = "10.0.0.2"
+  dhcp_server = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "dns_primary", "10.0.0.2"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "dhcp_server", "false"),
+				),
+			},
+			{
+				Config: providerConfig + networkConfig(name) + `
+# checks the subnet is updated in place
+resource "hpe_morpheus_subnet" "test" {
+  name        = "` + name + `-renamed"
+  network_id  = hpe_morpheus_network.test.id
+  cidr        = "10.0.3.0/24"
+  dns_primary = "10.0.0.4"
+  dhcp_server = true
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_subnet.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "dns_primary", "10.0.0.4"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_subnet.test", "dhcp_server", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package subnet
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetSubnetAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"subnet": {
+		"id": 9,
+		"name": "test",
+		"cidr": "10.0.1.0/24",
+		"gateway": "10.0.1.1",
+		"dhcpServer": true,
+		"visibility": "private",
+		"network": {"id": 5, "name": "net"},
+		"pool": {"id": 7, "name": "pool"},
+		"resourcePermission": {"all": true, "sites": [{"id": 1}]}
+	}}`
+
+	client := testclient.NewJSON(t, body)
+
+	state, _, diags := getSubnetAsState(context.Background(), 9, client)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, int64(9), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "10.0.1.0/24", state.Cidr.ValueString())
+	assert.Equal(t, int64(5), state.NetworkId.ValueInt64())
+	assert.Equal(t, int64(7), state.PoolId.ValueInt64())
+	assert.True(t, state.ResourcePermissions.All.ValueBool())
+}
+
+func TestGetSubnetAsStateNoPool(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.NewJSON(t, `{"subnet": {"id": 9, "name": "test", "pool": null}}`)
+
+	state, _, diags := getSubnetAsState(context.Background(), 9, client)
+	require.False(t, diags.HasError(), diags)
+
+	assert.True(t, state.PoolId.IsNull())
+}
+
+func TestGetSubnetAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getSubnetAsState(context.Background(), 9, client)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
@@ -0,0 +1,17 @@
+resource "hpe_morpheus_task" "example" {
+  name           = "Example task"
+  code           = "example-task"
+  type_code      = "script"
+  execute_target = "resource"
+  result_type    = "value"
+  labels         = ["automation"]
+
+  script = <<-EOT
+    #!/bin/bash
+    echo "hello from Example task"
+  EOT
+
+  retryable           = true
+  retry_count         = 3
+  retry_delay_seconds = 30
+}
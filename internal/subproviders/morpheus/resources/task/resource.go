@@ -0,0 +1,610 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_task"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = TaskResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// task is the task returned by the api. The sdk models the task as any of
+// the task types, none of which are populated when the response is decoded,
+// so the task is decoded from the response body instead.
+type task struct {
+	Id       *int64  `json:"id"`
+	Name     *string `json:"name"`
+	Code     *string `json:"code"`
+	TaskType *struct {
+		Code *string `json:"code"`
+	} `json:"taskType"`
+	Labels            []string `json:"labels"`
+	Visibility        *string  `json:"visibility"`
+	ResultType        *string  `json:"resultType"`
+	ExecuteTarget     *string  `json:"executeTarget"`
+	Retryable         *bool    `json:"retryable"`
+	RetryCount        *int64   `json:"retryCount"`
+	RetryDelaySeconds *float64 `json:"retryDelaySeconds"`
+	File              *struct {
+		SourceType *string `json:"sourceType"`
+		Content    *string `json:"content"`
+	} `json:"file"`
+	TaskOptions map[string]any `json:"taskOptions"`
+}
+
+// decodeTask decodes the task from the body of a task response, which the
+// sdk leaves readable after decoding
+func decodeTask(hresp *http.Response) (task, error) {
+	var body struct {
+		Task *task `json:"task"`
+	}
+
+	if err := json.NewDecoder(hresp.Body).Decode(&body); err != nil {
+		return task{}, err
+	}
+
+	if body.Task == nil {
+		return task{}, fmt.Errorf("task missing from response")
+	}
+
+	return *body.Task, nil
+}
+
+// normalizeScript strips the carriage returns and trailing newlines the api
+// may add or remove when storing a script
+func normalizeScript(s string) string {
+	return strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// scriptToType returns the script stored by the api. The prior value is kept
+// when it only differs in line endings, so that only changes to the script
+// content are reported as drift.
+func scriptToType(prior types.String, content *string) types.String {
+	script := convert.StrToTypeEmptyNull(content)
+	if script.IsNull() || prior.IsNull() || prior.IsUnknown() {
+		return script
+	}
+
+	if normalizeScript(prior.ValueString()) == normalizeScript(script.ValueString()) {
+		return prior
+	}
+
+	return script
+}
+
+// usernameOption returns the task option holding the credential username,
+// HTTP tasks authenticate against the url rather than the execution target
+func usernameOption(typeCode string) string {
+	if typeCode == "restTask" {
+		return "webUser"
+	}
+
+	return "username"
+}
+
+// passwordOption returns the task option holding the credential password
+func passwordOption(typeCode string) string {
+	if typeCode == "restTask" {
+		return "webPassword"
+	}
+
+	return "password"
+}
+
+// populate task resource model with current API values. The options and
+// credential password are not populated, as the API reports type specific
+// defaults and masks secrets, so callers carry them over from the plan or
+// prior state. The returned bool is false if the task no longer exists.
+func getTaskAsState(
+	ctx context.Context,
+	id int64,
+	prior TaskModel,
+	client *sdk.APIClient,
+) (TaskModel, bool, diag.Diagnostics) {
+	var state TaskModel
+	var diags diag.Diagnostics
+
+	_, hresp, err := client.AutomationAPI.GetTasks(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate task resource",
+			fmt.Sprintf("task %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	t, err := decodeTask(hresp)
+	if err != nil {
+		diags.AddError(
+			"populate task resource",
+			fmt.Sprintf("task %d: failed to decode response: ", id)+err.Error(),
+		)
+
+		return state, true, diags
+	}
+
+	state.Id = convert.Int64ToType(t.Id)
+	state.Name = convert.StrToType(t.Name)
+	state.Code = convert.StrToType(t.Code)
+	state.Labels = convert.StrSliceToSet(t.Labels)
+	state.Visibility = convert.StrToType(t.Visibility)
+	state.ResultType = convert.StrToTypeEmptyNull(t.ResultType)
+	state.ExecuteTarget = convert.StrToType(t.ExecuteTarget)
+	state.Retryable = convert.BoolToType(t.Retryable)
+	state.RetryCount = convert.Int64ToType(t.RetryCount)
+
+	state.RetryDelaySeconds = types.Int64Null()
+	if t.RetryDelaySeconds != nil {
+		state.RetryDelaySeconds = types.Int64Value(int64(*t.RetryDelaySeconds))
+	}
+
+	state.TypeCode = types.StringNull()
+	if t.TaskType != nil {
+		state.TypeCode = convert.StrToType(t.TaskType.Code)
+	}
+
+	state.Script = types.StringNull()
+	if t.File != nil {
+		state.Script = scriptToType(prior.Script, t.File.Content)
+	}
+
+	username, _ := t.TaskOptions[usernameOption(state.TypeCode.ValueString())].(string)
+	state.CredentialUsername = convert.StrToTypeEmptyNull(&username)
+
+	state.Options = prior.Options
+	state.CredentialPasswordWoVersion = prior.CredentialPasswordWoVersion
+
+	return state, true, diags
+}
+
+// taskOptions converts the dynamic options attribute to the map sent to the
+// API and adds the credentials to it. The password is only sent when not
+// nil.
+func taskOptions(
+	ctx context.Context,
+	plan TaskModel,
+	password *string,
+) (map[string]any, error) {
+	options := map[string]any{}
+
+	if !plan.Options.IsNull() && !plan.Options.IsUnknown() {
+		optionsAny, err := convert.ValueToAny(ctx, plan.Options.UnderlyingValue())
+		if err != nil {
+			return nil, err
+		}
+
+		optionsMap, ok := optionsAny.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("options must be a valid object/map")
+		}
+
+		options = optionsMap
+	}
+
+	typeCode := plan.TypeCode.ValueString()
+
+	if !plan.CredentialUsername.IsNull() {
+		options[usernameOption(typeCode)] = plan.CredentialUsername.ValueString()
+	}
+
+	if password != nil {
+		options[passwordOption(typeCode)] = *password
+	}
+
+	return options, nil
+}
+
+// taskFile returns the script of the plan as a local file, or nil for a task
+// without a script
+func taskFile(plan TaskModel) *sdk.AddTasksRequestTaskFile {
+	if plan.Script.IsNull() {
+		return nil
+	}
+
+	file := sdk.NewAddTasksRequestTaskFile("local")
+	file.SetContent(plan.Script.ValueString())
+
+	return file
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan, config TaskModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	var password *string
+	if !config.CredentialPasswordWo.IsNull() {
+		password = config.CredentialPasswordWo.ValueStringPointer()
+	}
+
+	options, err := taskOptions(ctx, plan, password)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+": failed to convert options: "+err.Error(),
+		)
+
+		return
+	}
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	t := sdk.NewAddTasksRequestTask(
+		name,
+		*sdk.NewAddTasksRequestTaskTaskType(plan.TypeCode.ValueString()),
+		plan.ExecuteTarget.ValueString(),
+	)
+	t.SetVisibility(plan.Visibility.ValueString())
+	t.SetTaskOptions(options)
+	t.SetRetryable(plan.Retryable.ValueBool())
+	t.SetRetryCount(int32(plan.RetryCount.ValueInt64()))
+	t.SetRetryDelaySeconds(float32(plan.RetryDelaySeconds.ValueInt64()))
+	t.File = taskFile(plan)
+	if labels != nil {
+		t.SetLabels(labels)
+	}
+	if !plan.Code.IsUnknown() && !plan.Code.IsNull() {
+		t.SetCode(plan.Code.ValueString())
+	}
+	if !plan.ResultType.IsNull() {
+		t.SetResultType(plan.ResultType.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.AutomationAPI.AddTasks(ctx).
+		AddTasksRequest(*sdk.NewAddTasksRequest(*t)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	created, err := decodeTask(hresp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+": failed to decode response: "+err.Error(),
+		)
+
+		return
+	}
+
+	if created.Id == nil {
+		resp.Diagnostics.AddError(
+			"create task resource",
+			"task "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *created.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getTaskAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create task resource",
+			fmt.Sprintf("task %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state, config TaskModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	var password *string
+	if !plan.CredentialPasswordWoVersion.Equal(state.CredentialPasswordWoVersion) {
+		if config.CredentialPasswordWo.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"update task resource",
+				fmt.Sprintf("task %s: 'credential_password_wo_version' changed, "+
+					"but 'credential_password_wo' is not set", name),
+			)
+
+			return
+		}
+		// an empty password clears it
+		p := config.CredentialPasswordWo.ValueString()
+		password = &p
+	}
+
+	options, err := taskOptions(ctx, plan, password)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update task resource",
+			"task "+name+": failed to convert options: "+err.Error(),
+		)
+
+		return
+	}
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update task resource",
+			"task "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	t := sdk.NewUpdateTasksRequestTask()
+	t.SetName(name)
+	t.SetVisibility(plan.Visibility.ValueString())
+	t.SetExecuteTarget(plan.ExecuteTarget.ValueString())
+	t.SetTaskOptions(options)
+	t.SetRetryable(plan.Retryable.ValueBool())
+	t.SetRetryCount(int32(plan.RetryCount.ValueInt64()))
+	t.SetRetryDelaySeconds(float32(plan.RetryDelaySeconds.ValueInt64()))
+	t.File = taskFile(plan)
+	// an empty list clears the labels
+	t.Labels = append([]string{}, labels...)
+	// a null result type clears it
+	t.ResultType.Set(plan.ResultType.ValueStringPointer())
+	if !plan.Code.IsUnknown() {
+		t.SetCode(plan.Code.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update task resource",
+			"task "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.AutomationAPI.UpdateTasks(ctx, id).
+		UpdateTasksRequest(*sdk.NewUpdateTasksRequest(*t)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update task resource",
+			"task "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	newState, found, pdiags := getTaskAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update task resource",
+			fmt.Sprintf("task %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: newState.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data TaskModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read task resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getTaskAsState(ctx, id, data, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read task resource",
+			fmt.Sprintf("task %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the task was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data TaskModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete task resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.AutomationAPI.RemoveTasks(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete task resource",
+			fmt.Sprintf("task %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import task resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
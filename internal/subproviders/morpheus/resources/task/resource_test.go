@@ -0,0 +1,202 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example task" Code "example-task"
+
+package task_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusTaskExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Code", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"code",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"type_code",
+			"script",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"execute_target",
+			"resource",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"script",
+			"#!/bin/bash\necho \"hello from "+name+"\"\n",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"retry_count",
+			"3",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_task.example",
+			"retry_delay_seconds",
+			"30",
+		),
+		resource.TestCheckTypeSetElemAttr(
+			"hpe_morpheus_task.example",
+			"labels.*",
+			"automation",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_task.example",
+				// the api may normalise line endings of the script
+				ImportStateVerifyIgnore: []string{"script"},
+				Check:                   checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusTaskUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_task" "test" {
+  name                           = "` + name + `"
+  type_code                      = "restTask"
+  execute_target                 = "local"
+  result_type                    = "json"
+  credential_username            = "api"
+  credential_password_wo         = "Secret123!"
+  credential_password_wo_version = 1
+
+  options = {
+    webUrl    = "https://example.com/api/before"
+    webMethod = "GET"
+    ignoreSSL = "on"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "result_type", "json"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "credential_username", "api"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "retryable", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the task is updated in place and the result type is cleared
+resource "hpe_morpheus_task" "test" {
+  name                           = "` + name + `-renamed"
+  type_code                      = "restTask"
+  execute_target                 = "local"
+  retryable                      = true
+  credential_username            = "api"
+  credential_password_wo         = "Secret456!"
+  credential_password_wo_version = 2
+
+  options = {
+    webUrl    = "https://example.com/api/after"
+    webMethod = "POST"
+    webBody   = "{}"
+  }
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_task.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "retryable", "true"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "options.webMethod", "POST"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_task.test", "credential_password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_task.test", "result_type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetTaskAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"task": {
+		"id": 5,
+		"name": "test",
+		"code": "test-code",
+		"taskType": {"id": 1, "code": "script", "name": "Shell Script"},
+		"labels": ["a"],
+		"visibility": "private",
+		"taskOptions": {"username": "admin", "password": "****"},
+		"file": {"id": 3, "sourceType": "local", "content": "echo hi\r\n"},
+		"resultType": null,
+		"executeTarget": "remote",
+		"retryable": true,
+		"retryCount": 3,
+		"retryDelaySeconds": 10.0,
+		"allowCustomConfig": false
+	}}`
+
+	prior := TaskModel{
+		Script:                      types.StringValue("echo hi"),
+		Options:                     types.DynamicNull(),
+		CredentialPasswordWoVersion: types.Int64Value(1),
+	}
+
+	client := testclient.NewJSON(t, body)
+
+	state, found, diags := getTaskAsState(context.Background(), 5, prior, client)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, int64(5), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "test-code", state.Code.ValueString())
+	assert.Equal(t, "script", state.TypeCode.ValueString())
+	assert.Equal(t, "remote", state.ExecuteTarget.ValueString())
+	assert.True(t, state.Retryable.ValueBool())
+	assert.Equal(t, int64(3), state.RetryCount.ValueInt64())
+	assert.Equal(t, int64(10), state.RetryDelaySeconds.ValueInt64())
+	assert.True(t, state.ResultType.IsNull())
+	assert.Equal(t, "admin", state.CredentialUsername.ValueString())
+	assert.Equal(t, int64(1), state.CredentialPasswordWoVersion.ValueInt64())
+	// line endings added by the api aren't reported as drift
+	assert.Equal(t, "echo hi", state.Script.ValueString())
+}
+
+func TestScriptToType(t *testing.T) {
+	t.Parallel()
+
+	content := func(s string) *string { return &s }
+
+	cases := map[string]struct {
+		prior   types.String
+		content *string
+		want    types.String
+	}{
+		"unchanged": {
+			prior:   types.StringValue("echo hi\n"),
+			content: content("echo hi\n"),
+			want:    types.StringValue("echo hi\n"),
+		},
+		"line endings": {
+			prior:   types.StringValue("echo a\necho b\n"),
+			content: content("echo a\r\necho b"),
+			want:    types.StringValue("echo a\necho b\n"),
+		},
+		"drift": {
+			prior:   types.StringValue("echo hi"),
+			content: content("echo changed"),
+			want:    types.StringValue("echo changed"),
+		},
+		"import": {
+			prior:   types.StringNull(),
+			content: content("echo hi"),
+			want:    types.StringValue("echo hi"),
+		},
+		"removed": {
+			prior:   types.StringValue("echo hi"),
+			content: content(""),
+			want:    types.StringNull(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, scriptToType(tc.prior, tc.content))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+resource "hpe_morpheus_tenant" "example" {
+  name           = "Customer A"
+  description    = "Customer A tenant"
+  role_id        = hpe_morpheus_role.tenant.id
+  subdomain      = "customera"
+  currency       = "USD"
+  account_number = "A-001"
+}
@@ -0,0 +1,395 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_tenant"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = TenantResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate tenant resource model with current API values. The returned bool
+// is false if the tenant no longer exists.
+func getTenantAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (TenantModel, bool, diag.Diagnostics) {
+	var state TenantModel
+	var diags diag.Diagnostics
+
+	t, hresp, err := client.TenantsAPI.GetTenant(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate tenant resource",
+			fmt.Sprintf("tenant %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	tenant := t.GetAccount()
+
+	state.Id = convert.Int64ToType(tenant.Id)
+	state.Name = convert.StrToType(tenant.Name)
+	state.Description = convert.StrToTypeEmptyNull(tenant.Description.Get())
+	state.Subdomain = convert.StrToType(tenant.Subdomain)
+	state.Currency = convert.StrToType(tenant.Currency)
+	state.AccountNumber = convert.StrToTypeEmptyNull(tenant.AccountNumber.Get())
+	state.Active = convert.BoolToType(tenant.Active)
+	state.RoleId = convert.Int64ToType(tenant.GetRole().Id)
+
+	return state, true, diags
+}
+
+// accountProperties returns the tenant attributes that the sdk request
+// types don't model. A null account number is sent to clear it.
+func accountProperties(plan TenantModel) map[string]any {
+	props := map[string]any{
+		"active":        plan.Active.ValueBool(),
+		"accountNumber": nil,
+	}
+
+	if !plan.AccountNumber.IsNull() {
+		props["accountNumber"] = plan.AccountNumber.ValueString()
+	}
+
+	return props
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan TenantModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	addTenant := sdk.NewAddTenantRequestAccount(name)
+
+	if !plan.Description.IsNull() {
+		addTenant.SetDescription(plan.Description.ValueString())
+	}
+
+	if !plan.RoleId.IsUnknown() {
+		role := sdk.NewAddTenantRequestAccountRole()
+		role.SetId(plan.RoleId.ValueInt64())
+		addTenant.SetRole(*role)
+	}
+
+	if !plan.Subdomain.IsUnknown() {
+		addTenant.SetSubdomain(plan.Subdomain.ValueString())
+	}
+
+	if !plan.Currency.IsUnknown() {
+		addTenant.SetCurrency(plan.Currency.ValueString())
+	}
+
+	addTenant.AdditionalProperties = accountProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create tenant resource",
+			"tenant "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addTenantReq := sdk.NewAddTenantRequest(*addTenant)
+
+	tenant, hresp, err := client.TenantsAPI.AddTenant(ctx).
+		AddTenantRequest(*addTenantReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create tenant resource",
+			"tenant "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if tenant.GetAccount().Id == nil {
+		resp.Diagnostics.AddError(
+			"create tenant resource",
+			"tenant "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *tenant.GetAccount().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getTenantAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create tenant resource",
+			fmt.Sprintf("tenant %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan TenantModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	updateTenant := sdk.NewUpdateTenantRequestAccountWithDefaults()
+	updateTenant.SetName(name)
+
+	if plan.Description.IsNull() {
+		updateTenant.SetDescriptionNil()
+	} else {
+		updateTenant.SetDescription(plan.Description.ValueString())
+	}
+
+	if !plan.RoleId.IsUnknown() {
+		role := sdk.NewUpdateTenantRequestAccountRole()
+		role.SetId(plan.RoleId.ValueInt64())
+		updateTenant.SetRole(*role)
+	}
+
+	if !plan.Subdomain.IsUnknown() {
+		updateTenant.SetSubdomain(plan.Subdomain.ValueString())
+	}
+
+	if !plan.Currency.IsUnknown() {
+		updateTenant.SetCurrency(plan.Currency.ValueString())
+	}
+
+	updateTenant.AdditionalProperties = accountProperties(plan)
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update tenant resource",
+			"tenant "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateTenantReq := sdk.NewUpdateTenantRequest(*updateTenant)
+
+	_, hresp, err := client.TenantsAPI.UpdateTenant(ctx, id).
+		UpdateTenantRequest(*updateTenantReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update tenant resource",
+			"tenant "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getTenantAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update tenant resource",
+			fmt.Sprintf("tenant %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data TenantModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read tenant resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getTenantAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read tenant resource",
+			fmt.Sprintf("tenant %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the tenant was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data TenantModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete tenant resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.EnvironmentsAPI.DeleteEnvironments(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete tenant resource",
+			fmt.Sprintf("tenant %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import tenant resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,179 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Customer A" Description "Customer A tenant" RoleId "hpe_morpheus_role.tenant.id" Subdomain "customera" Currency "USD" AccountNumber "A-001"
+
+package tenant_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+func tenantRoleConfig(name string) string {
+	return `
+resource "hpe_morpheus_role" "tenant" {
+  name      = "` + name + `-role"
+  role_type = "account"
+}
+`
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusTenantExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+	subdomain := strings.ToLower(acctest.RandString(10))
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "An example tenant",
+		"RoleId", "hpe_morpheus_role.tenant.id",
+		"Subdomain", subdomain,
+		"Currency", "EUR",
+		"AccountNumber", "A-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"description",
+			"An example tenant",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_tenant.example",
+			"role_id",
+			"hpe_morpheus_role.tenant",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"subdomain",
+			subdomain,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"currency",
+			"EUR",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"account_number",
+			"A-001",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_tenant.example",
+			"active",
+			"true",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + tenantRoleConfig(name) + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_tenant.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusTenantUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "hpe_morpheus_tenant" "test" {
+  name           = "` + name + `"
+  description    = "before"
+  account_number = "A-001"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_tenant.test", "active", "true"),
+					resource.TestCheckResourceAttrSet(
+						"hpe_morpheus_tenant.test", "role_id"),
+				),
+			},
+			{
+				Config: providerConfig + `
+# checks the tenant is deactivated and optional attributes are cleared
+resource "hpe_morpheus_tenant" "test" {
+  name   = "` + name + `-changed"
+  active = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_tenant.test", "name", name+"-changed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_tenant.test", "active", "false"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_tenant.test", "description"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_tenant.test", "account_number"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetTenantAsState(t *testing.T) {
+	t.Parallel()
+
+	body := `{"account": {
+		"id": 4,
+		"name": "test",
+		"description": "",
+		"subdomain": "test",
+		"currency": "USD",
+		"accountNumber": "",
+		"active": true,
+		"role": {"id": 2, "authority": "Tenant Admin"}
+	}}`
+
+	client := testclient.NewJSON(t, body)
+
+	state, _, diags := getTenantAsState(context.Background(), 4, client)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, int64(4), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, int64(2), state.RoleId.ValueInt64())
+	// unset strings are returned as empty strings, which match unset config
+	assert.True(t, state.Description.IsNull())
+	assert.True(t, state.AccountNumber.IsNull())
+}
+
+func TestGetTenantAsStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.New(t, http.NotFound)
+
+	_, found, diags := getTenantAsState(context.Background(), 4, client)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, found)
+}
@@ -0,0 +1,152 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/listresult"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/paginate"
+)
+
+var (
+	_ list.ListResource              = &ListResource{}
+	_ list.ListResourceWithConfigure = &ListResource{}
+)
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+// ListResource lists existing users for terraform query
+type ListResource struct {
+	configure.ResourceWithMorpheusConfigure
+}
+
+type ListModel struct {
+	Username types.String `tfsdk:"username"`
+	Phrase   types.String `tfsdk:"phrase"`
+	RoleId   types.Int64  `tfsdk:"role_id"`
+}
+
+func (r *ListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_morpheus_user"
+}
+
+func (r *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list users with this username",
+				MarkdownDescription: "Only list users with this username",
+			},
+			"phrase": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list users matching this search phrase",
+				MarkdownDescription: "Only list users matching this search phrase",
+			},
+			"role_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Only list users with this role",
+				MarkdownDescription: "Only list users with this role",
+			},
+		},
+	}
+}
+
+func (r *ListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config ListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		diags.AddError("list user resource", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	fetch := func(
+		ctx context.Context,
+		pageSize int64,
+		offset int64,
+	) ([]sdk.AddUserTenant200ResponseAllOfUser, *sdk.ListActivity200ResponseAllOfMeta, error) {
+		listReq := client.UsersAPI.ListUsers(ctx).Max(pageSize).Offset(offset)
+		if !config.Username.IsNull() {
+			listReq = listReq.Username(config.Username.ValueString())
+		}
+		if !config.Phrase.IsNull() {
+			listReq = listReq.Phrase(config.Phrase.ValueString())
+		}
+		if !config.RoleId.IsNull() {
+			listReq = listReq.RoleId(config.RoleId.ValueInt64())
+		}
+
+		users, hresp, err := listReq.Execute()
+		if err != nil || hresp.StatusCode != http.StatusOK {
+			return nil, nil, fmt.Errorf("users GET failed: %s", errors.ErrMsg(err, hresp))
+		}
+
+		return users.GetUsers(), users.Meta, nil
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for u, err := range paginate.Seq(ctx, fetch) {
+			if err != nil {
+				push(listresult.Error("list user resource", err.Error()))
+
+				return
+			}
+
+			if u.Id == nil {
+				continue
+			}
+
+			id := *u.Id
+			result := listresult.New(ctx, req, id, u.GetUsername(),
+				func() (UserModel, diag.Diagnostics) {
+					return getUserAsState(ctx, id, client)
+				},
+			)
+			if !push(result) {
+				return
+			}
+
+			count++
+			if listresult.LimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/user"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testlist"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	// more than one page of users
+	const total = 150
+
+	handler := testlist.Handler(t, "/api/users", "users", "user", total,
+		func(id int64) map[string]any {
+			return map[string]any{
+				"id":                   id,
+				"accountId":            1,
+				"username":             fmt.Sprintf("user-%d", id),
+				"email":                fmt.Sprintf("user-%d@example.com", id),
+				"firstName":            "Test",
+				"lastName":             "User",
+				"linuxUsername":        "linux",
+				"windowsUsername":      nil,
+				"linuxKeyPairId":       nil,
+				"passwordExpired":      false,
+				"receiveNotifications": true,
+				"roles":                []map[string]any{{"id": 3}},
+			}
+		},
+	)
+
+	results := testlist.Run(t,
+		user.NewListResource().(list.ListResourceWithConfigure),
+		user.NewResource().(resource.ResourceWithIdentity),
+		true, handler,
+	)
+	require.Len(t, results, total)
+
+	ctx := context.Background()
+	for i, result := range results {
+		id := int64(i + 1)
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, fmt.Sprintf("user-%d", id), result.DisplayName)
+
+		var ident identity.Model
+		require.False(t, result.Identity.Get(ctx, &ident).HasError())
+		assert.Equal(t, types.Int64Value(id), ident.Id)
+
+		var state user.UserModel
+		require.False(t, result.Resource.Get(ctx, &state).HasError())
+		assert.Equal(t, types.Int64Value(id), state.Id)
+		assert.Equal(t, types.Int64Value(1), state.TenantId)
+		assert.Equal(t, types.StringValue(fmt.Sprintf("user-%d", id)), state.Username)
+		assert.Equal(t,
+			types.StringValue(fmt.Sprintf("user-%d@example.com", id)), state.Email)
+		assert.Equal(t, types.StringValue("linux"), state.LinuxUsername)
+		assert.True(t, state.WindowsUsername.IsNull())
+		assert.Equal(t, types.BoolValue(true), state.ReceiveNotifications)
+		assert.Equal(t, types.SetValueMust(types.Int64Type,
+			[]attr.Value{types.Int64Value(3)}), state.RoleIds)
+	}
+}
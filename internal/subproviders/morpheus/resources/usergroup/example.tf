@@ -0,0 +1,7 @@
+resource "hpe_morpheus_user_group" "example" {
+  name            = "developers"
+  description     = "Application developers"
+  server_group    = "devs"
+  sudo_access     = true
+  member_user_ids = [hpe_morpheus_user.example.id]
+}
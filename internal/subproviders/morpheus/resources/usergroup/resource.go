@@ -0,0 +1,386 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package usergroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_user_group"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = UserGroupResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// populate user group resource model with current API values. The returned
+// bool is false if the user group no longer exists.
+func getUserGroupAsState(
+	ctx context.Context,
+	id int64,
+	client *sdk.APIClient,
+) (UserGroupModel, bool, diag.Diagnostics) {
+	var state UserGroupModel
+	var diags diag.Diagnostics
+
+	g, hresp, err := client.UsersAPI.GetUserGroup(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate user group resource",
+			fmt.Sprintf("user group %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	group := g.GetUserGroup()
+
+	var memberIDs []int64
+	for _, u := range group.Users {
+		memberIDs = append(memberIDs, u.GetId())
+	}
+
+	state.Id = convert.Int64ToType(group.Id)
+	state.Name = convert.StrToType(group.Name)
+	state.Description = convert.StrToTypeEmptyNull(group.Description.Get())
+	state.ServerGroup = convert.StrToTypeEmptyNull(group.ServerGroup.Get())
+	state.SudoAccess = convert.BoolToType(group.SudoUser)
+	state.MemberUserIds = convert.Int64SliceToSet(memberIDs)
+
+	return state, true, diags
+}
+
+// newUserGroup builds the request body shared by create and update. Null
+// attributes are sent explicitly so that an update clears them.
+func newUserGroup(plan UserGroupModel) (*sdk.AddUserGroupRequestUserGroup, error) {
+	userGroup := sdk.NewAddUserGroupRequestUserGroup()
+	userGroup.SetName(plan.Name.ValueString())
+	userGroup.SetSudoUser(plan.SudoAccess.ValueBool())
+
+	if plan.Description.IsNull() {
+		userGroup.SetDescriptionNil()
+	} else {
+		userGroup.SetDescription(plan.Description.ValueString())
+	}
+
+	if plan.ServerGroup.IsNull() {
+		userGroup.SetServerGroupNil()
+	} else {
+		userGroup.SetServerGroup(plan.ServerGroup.ValueString())
+	}
+
+	members, err := convert.SetToInt64Slice(plan.MemberUserIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member_user_ids: %w", err)
+	}
+
+	userGroup.SetUsers(members)
+
+	return userGroup, nil
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan UserGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	userGroup, err := newUserGroup(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create user group resource",
+			"user group "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create user group resource",
+			"user group "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	addUserGroupReq := sdk.NewAddUserGroupRequest(*userGroup)
+
+	g, hresp, err := client.UsersAPI.AddUserGroup(ctx).
+		AddUserGroupRequest(*addUserGroupReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create user group resource",
+			"user group "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	if g.GetUserGroup().Id == nil {
+		resp.Diagnostics.AddError(
+			"create user group resource",
+			"user group "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *g.GetUserGroup().Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getUserGroupAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create user group resource",
+			fmt.Sprintf("user group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan UserGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	userGroup, err := newUserGroup(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update user group resource",
+			"user group "+name+": "+err.Error(),
+		)
+
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update user group resource",
+			"user group "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	updateUserGroupReq := sdk.NewAddUserGroupRequest(*userGroup)
+
+	_, hresp, err := client.UsersAPI.UpdateUserGroup(ctx, id).
+		AddUserGroupRequest(*updateUserGroupReq).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update user group resource",
+			"user group "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getUserGroupAsState(ctx, id, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update user group resource",
+			fmt.Sprintf("user group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read user group resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getUserGroupAsState(ctx, id, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read user group resource",
+			fmt.Sprintf("user group %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the user group was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete user group resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.UsersAPI.DeleteUserGroup(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete user group resource",
+			fmt.Sprintf("user group %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import user group resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
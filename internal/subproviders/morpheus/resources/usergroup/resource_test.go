@@ -0,0 +1,137 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "developers" Description "Application developers" ServerGroup "devs" SudoAccess true MemberUserId "hpe_morpheus_user.example.id"
+
+package usergroup_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+func memberConfig(name string) string {
+	return `
+resource "hpe_morpheus_user" "member" {
+  username    = "` + name + `"
+  email       = "` + name + `@example.com"
+  password_wo = "Secret123!"
+  role_ids    = [3]
+}
+`
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusUserGroupExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := strings.ToLower(acctest.RandomWithPrefix("testacc-usergroup"))
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name,
+		"Description", "An example user group",
+		"ServerGroup", "devs",
+		"SudoAccess", "true",
+		"MemberUserId", "hpe_morpheus_user.member.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_user_group.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_user_group.example",
+			"server_group",
+			"devs",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_user_group.example",
+			"sudo_access",
+			"true",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_user_group.example",
+			"member_user_ids.#",
+			"1",
+		),
+		resource.TestCheckTypeSetElemAttrPair(
+			"hpe_morpheus_user_group.example",
+			"member_user_ids.*",
+			"hpe_morpheus_user.member",
+			"id",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + memberConfig(name) + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_user_group.example",
+				Check:             checkFn,
+			},
+			{
+				Config: providerConfig + memberConfig(name) + `
+# checks membership and optional attributes are cleared
+resource "hpe_morpheus_user_group" "example" {
+  name = "` + name + `"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_user_group.example", "member_user_ids"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_user_group.example", "description"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_user_group.example", "server_group"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_user_group.example", "sudo_access", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package usergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func TestGetUserGroupAsState(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body        string
+		description types.String
+		serverGroup types.String
+	}{
+		"set": {
+			body: `{"userGroup": {"id": 4, "name": "devs",
+				"description": "Developers", "serverGroup": "dev"}}`,
+			description: types.StringValue("Developers"),
+			serverGroup: types.StringValue("dev"),
+		},
+		"no description": {
+			body:        `{"userGroup": {"id": 4, "name": "devs"}}`,
+			description: types.StringNull(),
+			serverGroup: types.StringNull(),
+		},
+		"null description": {
+			body: `{"userGroup": {"id": 4, "name": "devs",
+				"description": null, "serverGroup": null}}`,
+			description: types.StringNull(),
+			serverGroup: types.StringNull(),
+		},
+		"empty description": {
+			body: `{"userGroup": {"id": 4, "name": "devs",
+				"description": "", "serverGroup": ""}}`,
+			description: types.StringNull(),
+			serverGroup: types.StringNull(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testclient.NewJSON(t, tc.body)
+
+			state, found, diags := getUserGroupAsState(context.Background(), 4, client)
+			require.False(t, diags.HasError(), diags)
+			assert.True(t, found)
+			assert.Equal(t, types.Int64Value(4), state.Id)
+			assert.Equal(t, tc.description, state.Description)
+			assert.Equal(t, tc.serverGroup, state.ServerGroup)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+resource "hpe_morpheus_task" "example" {
+  name           = "Example workflow task"
+  type_code      = "script"
+  execute_target = "resource"
+
+  script = <<-EOT
+    #!/bin/bash
+    echo "hello from Example workflow"
+  EOT
+}
+
+resource "hpe_morpheus_workflow" "example" {
+  name        = "Example workflow"
+  description = "Example provisioning workflow"
+  type        = "provision"
+  platform    = "linux"
+  labels      = ["automation"]
+
+  tasks = [
+    {
+      task_id = hpe_morpheus_task.example.id
+      phase   = "postProvision"
+    },
+  ]
+}
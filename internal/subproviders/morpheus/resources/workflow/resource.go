@@ -0,0 +1,540 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/configure"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/convert"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/errors"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithIdentity    = &Resource{}
+)
+
+// phases in the order they are run, used to order the tasks of a workflow
+// that wasn't created from this configuration
+var phases = []string{
+	"configure",
+	"price",
+	"preProvision",
+	"provision",
+	"postProvision",
+	"start",
+	"stop",
+	"preDeploy",
+	"deploy",
+	"reconfigure",
+	"teardown",
+	"shutdown",
+	"startup",
+	"operation",
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	configure.ResourceWithMorpheusConfigure
+	resource.Resource
+}
+
+func (r *Resource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + constants.SubProviderName + "_workflow"
+}
+
+func (r *Resource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = WorkflowResourceSchema(ctx)
+}
+
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identity.Schema()
+}
+
+// phaseTasks returns the task ids of each phase, in run order
+func phaseTasks(tasks []TasksValue) map[string][]int64 {
+	byPhase := map[string][]int64{}
+	for _, t := range tasks {
+		phase := t.Phase.ValueString()
+		byPhase[phase] = append(byPhase[phase], t.TaskId.ValueInt64())
+	}
+
+	return byPhase
+}
+
+// tasksToState returns the tasks of the workflow. The API orders tasks
+// within a phase but not across phases, so the prior tasks are kept when
+// each phase runs the same tasks in the same order. Otherwise the tasks are
+// listed in phase order.
+func tasksToState(
+	ctx context.Context,
+	prior types.List,
+	setTasks []sdk.ListWorkflows200ResponseAllOfTaskSetsInnerTaskSetTasksInner,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	setTasks = slices.Clone(setTasks)
+	slices.SortStableFunc(setTasks, func(
+		a, b sdk.ListWorkflows200ResponseAllOfTaskSetsInnerTaskSetTasksInner,
+	) int {
+		if c := slices.Index(phases, a.GetTaskPhase()) -
+			slices.Index(phases, b.GetTaskPhase()); c != 0 {
+			return c
+		}
+
+		return int(a.GetTaskOrder() - b.GetTaskOrder())
+	})
+
+	var tasks []TasksValue
+	for _, st := range setTasks {
+		if st.Task == nil {
+			continue
+		}
+
+		tasks = append(tasks, TasksValue{
+			TaskId: convert.Int64ToType(st.Task.Id),
+			Phase:  convert.StrToType(st.TaskPhase),
+			state:  attr.ValueStateKnown,
+		})
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorTasks []TasksValue
+		diags.Append(prior.ElementsAs(ctx, &priorTasks, false)...)
+		if diags.HasError() {
+			return prior, diags
+		}
+
+		if len(priorTasks) == len(tasks) &&
+			maps.EqualFunc(phaseTasks(priorTasks), phaseTasks(tasks), slices.Equal) {
+			return prior, diags
+		}
+	}
+
+	if len(tasks) == 0 {
+		return types.ListNull(TasksValue{}.Type(ctx)), diags
+	}
+
+	state, d := types.ListValueFrom(ctx, TasksValue{}.Type(ctx), tasks)
+	diags.Append(d...)
+
+	return state, diags
+}
+
+// populate workflow resource model with current API values. The returned
+// bool is false if the workflow no longer exists.
+func getWorkflowAsState(
+	ctx context.Context,
+	id int64,
+	prior WorkflowModel,
+	client *sdk.APIClient,
+) (WorkflowModel, bool, diag.Diagnostics) {
+	var state WorkflowModel
+	var diags diag.Diagnostics
+
+	w, hresp, err := client.AutomationAPI.GetWorkflows(ctx, id).Execute()
+	if hresp != nil && hresp.StatusCode == http.StatusNotFound {
+		return state, false, diags
+	}
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		diags.AddError(
+			"populate workflow resource",
+			fmt.Sprintf("workflow %d GET failed: ", id)+errors.ErrMsg(err, hresp),
+		)
+
+		return state, true, diags
+	}
+
+	taskSet := w.GetTaskSet()
+
+	state.Id = convert.Int64ToType(taskSet.Id)
+	state.Name = convert.StrToType(taskSet.Name)
+	state.Description = convert.StrToTypeEmptyNull(taskSet.Description.Get())
+	state.Labels = convert.StrSliceToSet(taskSet.Labels)
+	state.Type = convert.StrToType(taskSet.Type)
+	state.Visibility = convert.StrToType(taskSet.Visibility)
+	state.Platform = convert.StrToTypeEmptyNull(taskSet.Platform.Get())
+	state.AllowCustomConfig = convert.BoolToType(taskSet.AllowCustomConfig)
+
+	var optionTypeIds []int64
+	for _, o := range taskSet.OptionTypes {
+		if o.Id != nil {
+			optionTypeIds = append(optionTypeIds, *o.Id)
+		}
+	}
+	state.OptionTypeIds = convert.Int64SliceToSet(optionTypeIds)
+
+	tasks, d := tasksToState(ctx, prior.Tasks, taskSet.TaskSetTasks)
+	diags.Append(d...)
+	state.Tasks = tasks
+
+	return state, true, diags
+}
+
+// workflowProperties returns the workflow settings the sdk doesn't model.
+// The sdk models the tasks as a single task rather than a list, so the tasks
+// are sent as an additional property, which takes precedence over the typed
+// field.
+func workflowProperties(
+	ctx context.Context,
+	plan WorkflowModel,
+) (map[string]any, diag.Diagnostics) {
+	tasks, diags := convert.FromListType(ctx, plan.Tasks,
+		func(t TasksValue) map[string]any {
+			return map[string]any{
+				"taskId":    t.TaskId.ValueInt64(),
+				"taskPhase": t.Phase.ValueString(),
+			}
+		},
+	)
+
+	properties := map[string]any{
+		// an empty list clears the tasks
+		"tasks":             append([]map[string]any{}, tasks...),
+		"allowCustomConfig": plan.AllowCustomConfig.ValueBool(),
+		// a null platform clears it
+		"platform":   plan.Platform.ValueStringPointer(),
+		"visibility": plan.Visibility.ValueString(),
+	}
+
+	return properties, diags
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan WorkflowModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			"workflow "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	optionTypeIds, err := convert.SetToInt64Slice(plan.OptionTypeIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			"workflow "+name+": failed to convert option type ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	properties, d := workflowProperties(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskSet := sdk.NewAddWorkflowsRequestTaskSet(name)
+	taskSet.SetType(plan.Type.ValueString())
+	taskSet.AdditionalProperties = properties
+	if labels != nil {
+		taskSet.SetLabels(labels)
+	}
+	if optionTypeIds != nil {
+		taskSet.SetOptionTypes(optionTypeIds)
+	}
+	if !plan.Description.IsNull() {
+		taskSet.SetDescription(plan.Description.ValueString())
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			"workflow "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	w, hresp, err := client.AutomationAPI.AddWorkflows(ctx).
+		AddWorkflowsRequest(*sdk.NewAddWorkflowsRequest(*taskSet)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			"workflow "+name+" POST failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	created := w.TaskSet
+	if created == nil || created.Id == nil {
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			"workflow "+name+": id is nil",
+		)
+
+		return
+	}
+
+	id := *created.Id
+	plan.Id = types.Int64Value(id)
+
+	// write id as soon as possible
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: plan.Id})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, pdiags := getWorkflowAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"create workflow resource",
+			fmt.Sprintf("workflow %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan WorkflowModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	labels, err := convert.SetToStrSlice(plan.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update workflow resource",
+			"workflow "+name+": failed to convert labels: "+err.Error(),
+		)
+
+		return
+	}
+
+	optionTypeIds, err := convert.SetToInt64Slice(plan.OptionTypeIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update workflow resource",
+			"workflow "+name+": failed to convert option type ids: "+err.Error(),
+		)
+
+		return
+	}
+
+	properties, d := workflowProperties(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskSet := sdk.NewUpdateWorkflowsRequestTaskSet()
+	taskSet.SetName(name)
+	taskSet.SetType(plan.Type.ValueString())
+	// an empty description clears it
+	taskSet.SetDescription(plan.Description.ValueString())
+	// empty lists clear the labels and option types
+	taskSet.Labels = append([]string{}, labels...)
+	taskSet.OptionTypes = append([]int64{}, optionTypeIds...)
+	taskSet.AdditionalProperties = properties
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"update workflow resource",
+			"workflow "+name+": failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	id := plan.Id.ValueInt64()
+
+	_, hresp, err := client.AutomationAPI.UpdateWorkflows(ctx, id).
+		UpdateWorkflowsRequest(*sdk.NewUpdateWorkflowsRequest(*taskSet)).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"update workflow resource",
+			"workflow "+name+" PUT failed: "+errors.ErrMsg(err, hresp),
+		)
+
+		return
+	}
+
+	state, found, pdiags := getWorkflowAsState(ctx, id, plan, client)
+	if pdiags.HasError() || !found {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"update workflow resource",
+			fmt.Sprintf("workflow %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data WorkflowModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"read workflow resource",
+			"new client call failed with "+err.Error(),
+		)
+
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	state, found, pdiags := getWorkflowAsState(ctx, id, data, client)
+	if pdiags.HasError() {
+		resp.Diagnostics.Append(pdiags...)
+		resp.Diagnostics.AddError(
+			"read workflow resource",
+			fmt.Sprintf("workflow %d: failed to read from api", id),
+		)
+
+		return
+	}
+
+	// the workflow was deleted outside of terraform, so plan to recreate it
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(
+		resp.Identity.Set(ctx, identity.Model{Id: state.Id})...,
+	)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data WorkflowModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.Id.ValueInt64()
+
+	client, err := r.NewClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"delete workflow resource",
+			"failed to create client: "+err.Error(),
+		)
+
+		return
+	}
+
+	_, hresp, err := client.AutomationAPI.RemoveWorkflows(ctx, id).Execute()
+	if err != nil || hresp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"delete workflow resource",
+			fmt.Sprintf("workflow %d: DELETE failed ", id)+errors.ErrMsg(err, hresp),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	// import block using identity rather than id
+	if req.ID == "" {
+		identity.ImportState(ctx, req, resp)
+
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"import workflow resource",
+			"provided import ID '"+req.ID+"' is invalid (non-number)",
+		)
+
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,206 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+//go:generate go run ../../../../../cmd/render example.tf.tmpl Name "Example workflow"
+
+package workflow_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/HPE/terraform-provider-hpe/internal/provider"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	testhelpers.WriteMergedResults()
+	os.Exit(code)
+}
+
+func newProviderWithError() (tfprotov6.ProviderServer, error) {
+	providerInstance := provider.New("test", morpheus.New())()
+
+	return providerserver.NewProtocol6WithError(providerInstance)()
+}
+
+var testAccProtoV6ProviderFactories = map[string]func() (
+	tfprotov6.ProviderServer, error,
+){
+	"hpe": newProviderWithError,
+}
+
+// Tests that our example file template used for docs is a valid config
+func TestAccMorpheusWorkflowExampleOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	resourceConfig, err := testhelpers.RenderExample(t, "example.tf.tmpl",
+		"Name", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_workflow.example",
+			"name",
+			name,
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_workflow.example",
+			"type",
+			"provision",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_workflow.example",
+			"platform",
+			"linux",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_workflow.example",
+			"tasks.#",
+			"1",
+		),
+		resource.TestCheckResourceAttrPair(
+			"hpe_morpheus_workflow.example",
+			"tasks.0.task_id",
+			"hpe_morpheus_task.example",
+			"id",
+		),
+		resource.TestCheckResourceAttr(
+			"hpe_morpheus_workflow.example",
+			"tasks.0.phase",
+			"postProvision",
+		),
+		resource.TestCheckTypeSetElemAttr(
+			"hpe_morpheus_workflow.example",
+			"labels.*",
+			"automation",
+		),
+	}
+
+	checkFn := resource.ComposeAggregateTestCheckFunc(checks...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check:  checkFn,
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true, // Check state post import
+				ResourceName:      "hpe_morpheus_workflow.example",
+				Check:             checkFn,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusWorkflowUpdateOk(t *testing.T) {
+	defer testhelpers.RecordResult(t)
+	if testing.Short() {
+		t.Skip("Skipping slow test in short mode")
+	}
+
+	providerConfig := testhelpers.ProviderBlock()
+
+	name := acctest.RandomWithPrefix(t.Name())
+
+	tasks := `
+resource "hpe_morpheus_task" "first" {
+  name           = "` + name + `-first"
+  type_code      = "script"
+  execute_target = "resource"
+  script         = "echo first"
+}
+
+resource "hpe_morpheus_task" "second" {
+  name           = "` + name + `-second"
+  type_code      = "script"
+  execute_target = "resource"
+  script         = "echo second"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + tasks + `
+resource "hpe_morpheus_workflow" "test" {
+  name     = "` + name + `"
+  type     = "operation"
+  platform = "all"
+
+  tasks = [
+    { task_id = hpe_morpheus_task.first.id, phase = "operation" },
+    { task_id = hpe_morpheus_task.second.id, phase = "operation" },
+  ]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"hpe_morpheus_workflow.test", "tasks.0.task_id",
+						"hpe_morpheus_task.first", "id"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_workflow.test", "allow_custom_config", "false"),
+				),
+			},
+			{
+				Config: providerConfig + tasks + `
+# checks the workflow is updated in place, the tasks reordered and the
+# platform cleared
+resource "hpe_morpheus_workflow" "test" {
+  name                = "` + name + `-renamed"
+  description         = "updated"
+  type                = "operation"
+  visibility          = "public"
+  allow_custom_config = true
+
+  tasks = [
+    { task_id = hpe_morpheus_task.second.id, phase = "operation" },
+    { task_id = hpe_morpheus_task.first.id, phase = "operation" },
+  ]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"hpe_morpheus_workflow.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_workflow.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_workflow.test", "description", "updated"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_workflow.test", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"hpe_morpheus_workflow.test", "allow_custom_config", "true"),
+					resource.TestCheckResourceAttrPair(
+						"hpe_morpheus_workflow.test", "tasks.0.task_id",
+						"hpe_morpheus_task.second", "id"),
+					resource.TestCheckNoResourceAttr(
+						"hpe_morpheus_workflow.test", "platform"),
+				),
+			},
+		},
+	})
+}
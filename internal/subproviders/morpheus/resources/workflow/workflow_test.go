@@ -0,0 +1,165 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+//go:build experimental
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+func newTasks(t *testing.T, tasks ...TasksValue) types.List {
+	t.Helper()
+
+	l, diags := types.ListValueFrom(
+		context.Background(), TasksValue{}.Type(context.Background()), tasks,
+	)
+	require.False(t, diags.HasError(), diags)
+
+	return l
+}
+
+func newTask(id int64, phase string) TasksValue {
+	return TasksValue{
+		TaskId: types.Int64Value(id),
+		Phase:  types.StringValue(phase),
+		state:  attr.ValueStateKnown,
+	}
+}
+
+const body = `{"taskSet": {
+	"id": 7,
+	"name": "test",
+	"type": "provision",
+	"description": null,
+	"labels": ["a"],
+	"platform": "linux",
+	"visibility": "private",
+	"allowCustomConfig": true,
+	"tasks": [1, 2, 3],
+	"optionTypes": [{"id": 4, "name": "opt"}],
+	"taskSetTasks": [
+		{"id": 10, "taskPhase": "provision", "taskOrder": 1, "task": {"id": 3}},
+		{"id": 11, "taskPhase": "postProvision", "taskOrder": 0, "task": {"id": 1}},
+		{"id": 12, "taskPhase": "provision", "taskOrder": 0, "task": {"id": 2}}
+	]
+}}`
+
+func TestGetWorkflowAsState(t *testing.T) {
+	t.Parallel()
+
+	client := testclient.NewJSON(t, body)
+
+	state, found, diags := getWorkflowAsState(
+		context.Background(), 7, WorkflowModel{Tasks: types.ListNull(
+			TasksValue{}.Type(context.Background()),
+		)}, client,
+	)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+
+	assert.Equal(t, int64(7), state.Id.ValueInt64())
+	assert.Equal(t, "test", state.Name.ValueString())
+	assert.Equal(t, "provision", state.Type.ValueString())
+	assert.Equal(t, "linux", state.Platform.ValueString())
+	assert.True(t, state.Description.IsNull())
+	assert.True(t, state.AllowCustomConfig.ValueBool())
+	assert.Equal(t, types.SetValueMust(
+		types.Int64Type, []attr.Value{types.Int64Value(4)},
+	), state.OptionTypeIds)
+	// tasks are listed in phase order without a prior configuration
+	assert.Equal(t, newTasks(t,
+		newTask(2, "provision"),
+		newTask(3, "provision"),
+		newTask(1, "postProvision"),
+	), state.Tasks)
+}
+
+func TestTasksToState(t *testing.T) {
+	t.Parallel()
+
+	setTasks := []sdk.ListWorkflows200ResponseAllOfTaskSetsInnerTaskSetTasksInner{}
+	for _, st := range []struct {
+		id    int64
+		phase string
+		order int64
+	}{
+		{1, "postProvision", 0},
+		{2, "provision", 0},
+		{3, "provision", 1},
+	} {
+		task := sdk.NewListWorkflows200ResponseAllOfTaskSetsInnerTaskSetTasksInnerTask()
+		task.SetId(st.id)
+
+		setTask := sdk.NewListWorkflows200ResponseAllOfTaskSetsInnerTaskSetTasksInner()
+		setTask.SetTaskPhase(st.phase)
+		setTask.SetTaskOrder(st.order)
+		setTask.SetTask(*task)
+
+		setTasks = append(setTasks, *setTask)
+	}
+
+	phaseOrdered := newTasks(t,
+		newTask(2, "provision"),
+		newTask(3, "provision"),
+		newTask(1, "postProvision"),
+	)
+
+	cases := map[string]struct {
+		prior types.List
+		want  types.List
+	}{
+		"import": {
+			prior: types.ListNull(TasksValue{}.Type(context.Background())),
+			want:  phaseOrdered,
+		},
+		"phases interleaved": {
+			prior: newTasks(t,
+				newTask(1, "postProvision"),
+				newTask(2, "provision"),
+				newTask(3, "provision"),
+			),
+			want: newTasks(t,
+				newTask(1, "postProvision"),
+				newTask(2, "provision"),
+				newTask(3, "provision"),
+			),
+		},
+		"reordered within phase": {
+			prior: newTasks(t,
+				newTask(3, "provision"),
+				newTask(2, "provision"),
+				newTask(1, "postProvision"),
+			),
+			want: phaseOrdered,
+		},
+		"task removed": {
+			prior: newTasks(t,
+				newTask(2, "provision"),
+				newTask(3, "provision"),
+				newTask(4, "provision"),
+				newTask(1, "postProvision"),
+			),
+			want: phaseOrdered,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tasksToState(context.Background(), tc.prior, setTasks)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
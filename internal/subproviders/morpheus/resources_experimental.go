@@ -20,10 +20,36 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/catalogitem"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/cloud"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/environment"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/group"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/groupcloudattachment"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/keypair"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/loadbalancer"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/loadbalancerpool"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/loadbalancervirtualserver"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/network"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/networkdomain"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/networkgroup"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/networkpool"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/networkpoolip"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/networkproxy"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/optionlist"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/optiontype"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/policy"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/price"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/priceset"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/role"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/securitygroup"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/securitygrouprule"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/serviceplan"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/subnet"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/task"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/tenant"
 	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/user"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/usergroup"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/resources/workflow"
 )
 
 func (s SubProvider) GetResources(
@@ -34,6 +60,32 @@ func (s SubProvider) GetResources(
 		network.NewResource,
 		user.NewResource,
 		role.NewResource,
+		environment.NewResource,
+		tenant.NewResource,
+		usergroup.NewResource,
+		keypair.NewResource,
+		networkpool.NewResource,
+		networkpoolip.NewResource,
+		networkdomain.NewResource,
+		networkproxy.NewResource,
+		networkgroup.NewResource,
+		subnet.NewResource,
+		securitygroup.NewResource,
+		securitygrouprule.NewResource,
+		loadbalancer.NewResource,
+		loadbalancerpool.NewResource,
+		loadbalancervirtualserver.NewResource,
+		cloud.NewResource,
+		groupcloudattachment.NewResource,
+		price.NewResource,
+		priceset.NewResource,
+		serviceplan.NewResource,
+		policy.NewResource,
+		task.NewResource,
+		workflow.NewResource,
+		optionlist.NewResource,
+		optiontype.NewResource,
+		catalogitem.NewResource,
 	}
 
 	return resources
@@ -0,0 +1,53 @@
+package testhelpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+)
+
+// UpdateSecurityGroupRulePortRange changes the port range of a tcp rule out
+// of band, as if it had been edited in the UI
+func UpdateSecurityGroupRulePortRange(
+	t *testing.T, groupId, id int64, portRange string,
+) error {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	client := newClient(ctx, t)
+
+	rule := sdk.NewUpdateSecurityGroupRulesRequestRule("tcp", "customRule")
+	rule.SetPortRange(portRange)
+
+	_, resp, err := client.SecurityGroupsAPI.
+		UpdateSecurityGroupRules(ctx, groupId, float32(id)).
+		UpdateSecurityGroupRulesRequest(*sdk.NewUpdateSecurityGroupRulesRequest(*rule)).
+		Execute()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("PUT failed for Security Group %d Rule %d: %w", groupId, id, err)
+	}
+
+	return nil
+}
+
+// DeleteSecurityGroupRule removes a rule out of band, as if it had been
+// deleted in the UI
+func DeleteSecurityGroupRule(t *testing.T, groupId, id int64) error {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	client := newClient(ctx, t)
+
+	_, resp, err := client.SecurityGroupsAPI.
+		RemoveSecurityGroupRules(ctx, groupId, float32(id)).Execute()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("DELETE failed for Security Group %d Rule %d: %w", groupId, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package testclient provides sdk clients backed by a local test server, for
+// offline tests of code that calls the api. It is separate from testhelpers,
+// which imports the provider and so can't be imported by a resource
+// package's own tests.
+package testclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HewlettPackard/hpe-morpheus-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/clientfactory"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/constants"
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/model"
+)
+
+// New returns a client whose requests are served by handler. The server is
+// closed when the test completes.
+func New(t *testing.T, handler http.HandlerFunc) *sdk.APIClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := sdk.NewConfiguration()
+	cfg.Servers[0].URL = server.URL
+
+	return sdk.NewAPIClient(cfg)
+}
+
+// NewJSON returns a client whose requests are all answered with body
+func NewJSON(t *testing.T, body string) *sdk.APIClient {
+	t.Helper()
+
+	return New(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+// ProviderData returns the provider data passed to Configure, so that the
+// clients of a configured resource are served by handler. The server is
+// closed when the test completes.
+func ProviderData(t *testing.T, handler http.HandlerFunc) map[string]any {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cf := clientfactory.New(model.SubModel{
+		URL:         types.StringValue(server.URL),
+		AccessToken: types.StringValue("token"),
+	})
+
+	return map[string]any{constants.SubProviderName: cf}
+}
@@ -0,0 +1,136 @@
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+// Package testlist runs list resources against a local test server, for
+// offline tests of terraform query support
+package testlist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/HPE/terraform-provider-hpe/internal/subproviders/morpheus/testhelpers/testclient"
+)
+
+// Run lists with an empty list config, the requests being served by
+// handler, and returns every result. The results include the full resource
+// of r when includeResource is set.
+func Run(
+	t *testing.T,
+	lr list.ListResourceWithConfigure,
+	r resource.ResourceWithIdentity,
+	includeResource bool,
+	handler http.HandlerFunc,
+) []list.ListResult {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var configureResp resource.ConfigureResponse
+	lr.Configure(ctx, resource.ConfigureRequest{
+		ProviderData: testclient.ProviderData(t, handler),
+	}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	var configSchemaResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	// every list config attribute is left unset
+	configType := configSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, attrs),
+		},
+		IncludeResource:        includeResource,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// Handler serves the paged list endpoint at path, listing total objects with
+// ids from 1 under key, and serves path/{id} with the single object under
+// singular. object returns the api object with the given id.
+func Handler(
+	t *testing.T,
+	path string,
+	key string,
+	singular string,
+	total int64,
+	object func(id int64) map[string]any,
+) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+
+		switch {
+		case r.URL.Path == path:
+			offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+			pageSize, err := strconv.ParseInt(r.URL.Query().Get("max"), 10, 64)
+			assert.NoError(t, err, "max")
+
+			objects := []map[string]any{}
+			for id := offset + 1; id <= min(offset+pageSize, total); id++ {
+				objects = append(objects, object(id))
+			}
+
+			body = map[string]any{
+				key: objects,
+				"meta": map[string]any{
+					"offset": offset,
+					"max":    pageSize,
+					"size":   len(objects),
+					"total":  total,
+				},
+			}
+		case strings.HasPrefix(r.URL.Path, path+"/"):
+			id, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, path+"/"), 10, 64)
+			if err != nil || id < 1 || id > total {
+				http.NotFound(w, r)
+
+				return
+			}
+
+			body = map[string]any{singular: object(id)}
+		default:
+			http.NotFound(w, r)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(body))
+	}
+}
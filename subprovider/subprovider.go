@@ -5,7 +5,9 @@ package subprovider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -17,3 +19,17 @@ type SubProvider interface {
 	GetDataSources(context.Context) []func() datasource.DataSource
 	GetResources(context.Context) []func() resource.Resource
 }
+
+// SubProviderWithActions is an optional extension of SubProvider for
+// subproviders that expose terraform actions
+type SubProviderWithActions interface {
+	SubProvider
+	GetActions(context.Context) []func() action.Action
+}
+
+// SubProviderWithListResources is an optional extension of SubProvider for
+// subproviders that expose list resources to terraform query
+type SubProviderWithListResources interface {
+	SubProvider
+	GetListResources(context.Context) []func() list.ListResource
+}